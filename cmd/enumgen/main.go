@@ -0,0 +1,422 @@
+// Command enumgen generates the boilerplate shared by every string enum in
+// a package: the All* slice, IsValid, Values, EnumName, String, Label, the
+// GraphQL, JSON and database/sql (un)marshalling methods, plus an init
+// function that adds each enum to the package's registry.
+//
+// It is meant to be invoked through go generate, e.g.
+//
+//	//go:generate go run ./cmd/enumgen -type=Gender,FieldType
+//
+// For each named type, every constant declared with that type (in any
+// non-test file of the package) becomes a value of the enum, in declaration
+// order. The doc comments of the type and its constants are registered as
+// descriptions and a "Deprecated:" paragraph marks a value as deprecated.
+//
+// A constant preceded or followed on the same line by the directive
+//
+//	//enumgen:fallback
+//
+// is the value that unknown database values are mapped to when a column is
+// scanned with Lenient. At most one constant per type may be marked.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	defaultOutput     = "enums_gen.go"
+	fallbackDirective = "//enumgen:fallback"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+
+	typeNames := flag.String("type", "", "comma-separated list of enum type names; required")
+	output := flag.String("output", defaultOutput, "output file name")
+	flag.Parse()
+
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if args := flag.Args(); len(args) > 0 {
+		dir = args[0]
+	}
+
+	src, err := generate(dir, *output, strings.Split(*typeNames, ","))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, *output), src, 0o644); err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+}
+
+// enumValue is a single constant belonging to an enum type
+type enumValue struct {
+	Name        string
+	Value       string
+	Description string
+	Deprecated  string
+}
+
+// enumType describes an enum type and its constants
+type enumType struct {
+	Name        string
+	Description string
+	Fallback    string
+	Values      []enumValue
+}
+
+// generate parses the package in dir and returns the formatted source of the
+// generated file for the requested types. The output file itself is skipped
+// so that a stale copy never affects generation.
+func generate(dir, output string, typeNames []string) ([]byte, error) {
+	pkgName, files, err := parsePackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	enums := []enumType{}
+	for _, name := range typeNames {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		enum, err := collectEnum(files, name)
+		if err != nil {
+			return nil, err
+		}
+		enums = append(enums, enum)
+	}
+
+	var buf bytes.Buffer
+	err = fileTemplate.Execute(&buf, struct {
+		Package string
+		Enums   []enumType
+	}{
+		Package: pkgName,
+		Enums:   enums,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// parsePackage parses every non-test Go file in dir except the output file
+func parsePackage(dir, output string) (string, []*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	pkgName := ""
+	files := []*ast.File{}
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if pkgName == "" {
+			pkgName = file.Name.Name
+		} else if file.Name.Name != pkgName {
+			return "", nil, fmt.Errorf("multiple packages in %s: %s and %s", dir, pkgName, file.Name.Name)
+		}
+		files = append(files, file)
+	}
+
+	if pkgName == "" {
+		return "", nil, fmt.Errorf("no Go files found in %s", dir)
+	}
+	return pkgName, files, nil
+}
+
+// collectEnum finds the declaration of the named type and all the string
+// constants declared with it
+func collectEnum(files []*ast.File, name string) (enumType, error) {
+	enum := enumType{Name: name}
+	declared := false
+	seen := map[string]string{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.Name != name {
+						continue
+					}
+					if ident, ok := spec.Type.(*ast.Ident); !ok || ident.Name != "string" {
+						return enum, fmt.Errorf("%s must be declared as a string type", name)
+					}
+					declared = true
+					enum.Description, _ = parseDoc(specDoc(gen, spec.Doc), name)
+
+				case *ast.ValueSpec:
+					if gen.Tok != token.CONST {
+						continue
+					}
+					if ident, ok := spec.Type.(*ast.Ident); !ok || ident.Name != name {
+						continue
+					}
+
+					for i, ident := range spec.Names {
+						if i >= len(spec.Values) {
+							return enum, fmt.Errorf("%s has no explicit value", ident.Name)
+						}
+						lit, ok := spec.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							return enum, fmt.Errorf("%s must be a string literal", ident.Name)
+						}
+						value, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							return enum, fmt.Errorf("%s: %w", ident.Name, err)
+						}
+
+						if other, ok := seen[value]; ok {
+							return enum, fmt.Errorf("%s and %s share the value %q", other, ident.Name, value)
+						}
+						seen[value] = ident.Name
+
+						doc := specDoc(gen, spec.Doc)
+						if hasDirective(doc, fallbackDirective) || hasDirective(spec.Comment, fallbackDirective) {
+							if enum.Fallback != "" {
+								return enum, fmt.Errorf("%s and %s are both marked as the %s fallback", enum.Fallback, ident.Name, name)
+							}
+							enum.Fallback = ident.Name
+						}
+
+						description, deprecated := parseDoc(doc, ident.Name)
+						enum.Values = append(enum.Values, enumValue{
+							Name:        ident.Name,
+							Value:       value,
+							Description: description,
+							Deprecated:  deprecated,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	if !declared {
+		return enum, fmt.Errorf("type %s not found", name)
+	}
+	if len(enum.Values) == 0 {
+		return enum, fmt.Errorf("no constants found for %s", name)
+	}
+	return enum, nil
+}
+
+// specDoc returns the doc comment of a spec, falling back to the doc comment
+// of its declaration when the declaration is not grouped
+func specDoc(gen *ast.GenDecl, doc *ast.CommentGroup) *ast.CommentGroup {
+	if doc == nil && !gen.Lparen.IsValid() {
+		return gen.Doc
+	}
+	return doc
+}
+
+// hasDirective returns true if the doc comment contains the directive
+func hasDirective(doc *ast.CommentGroup, directive string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == directive {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDoc splits a doc comment into its description and the reason given in
+// a "Deprecated:" paragraph, if any. Placeholder comments such as
+// "// GenderMale ..." are treated as empty.
+func parseDoc(doc *ast.CommentGroup, name string) (description, deprecated string) {
+	if doc == nil {
+		return "", ""
+	}
+
+	paragraphs := []string{}
+	for _, paragraph := range strings.Split(doc.Text(), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		if reason, ok := strings.CutPrefix(paragraph, "Deprecated:"); ok {
+			deprecated = strings.Join(strings.Fields(reason), " ")
+			continue
+		}
+		paragraphs = append(paragraphs, paragraph)
+	}
+
+	description = strings.Join(paragraphs, "\n\n")
+	if description == name+" ..." {
+		description = ""
+	}
+	return description, deprecated
+}
+
+var fileTemplate = template.Must(template.New("enums").Parse(`// Code generated by enumgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strconv"
+)
+{{ range .Enums }}
+// All{{ .Name }} is a list of all valid {{ .Name }} values
+var All{{ .Name }} = []{{ .Name }}{
+{{- range .Values }}
+	{{ .Name }},
+{{- end }}
+}
+
+// IsValid returns true if the {{ .Name }} value is valid
+func (e {{ .Name }}) IsValid() bool {
+	switch e {
+	case {{ range $i, $v := .Values }}{{ if $i }},
+		{{ end }}{{ $v.Name }}{{ end }}:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid {{ .Name }} values as plain strings
+func (e {{ .Name }}) Values() []string {
+	return []string{
+	{{- range .Values }}
+		string({{ .Name }}),
+	{{- end }}
+	}
+}
+
+// EnumName returns the name under which {{ .Name }} is registered
+func (e {{ .Name }}) EnumName() string {
+	return "{{ .Name }}"
+}
+
+// String renders the {{ .Name }} value as a plain string
+func (e {{ .Name }}) String() string {
+	return string(e)
+}
+
+// Label returns the {{ .Name }} value as text for people to read in the supplied language
+func (e {{ .Name }}) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a {{ .Name }} value
+func (e *{{ .Name }}) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = {{ .Name }}(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the {{ .Name }} value to the supplied writer as a quoted string
+func (e {{ .Name }}) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a {{ .Name }} value
+func (e *{{ .Name }}) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the {{ .Name }} value, if valid, as a JSON string and the zero value as null
+func (e {{ .Name }}) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a {{ .Name }} value
+func (e *{{ .Name }}) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the {{ .Name }} value before it is written to the database
+func (e {{ .Name }}) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+{{ end }}
+func init() {
+{{- range .Enums }}
+	Register(EnumInfo{
+		Name:        "{{ .Name }}",
+		{{- if .Description }}
+		Description: {{ printf "%q" .Description }},
+		{{- end }}
+		{{- if .Fallback }}
+		Fallback: string({{ .Fallback }}),
+		{{- end }}
+		Values: []EnumValue{
+		{{- range .Values }}
+			{
+				Name:  "{{ .Name }}",
+				Value: string({{ .Name }}),
+				{{- if .Description }}
+				Description: {{ printf "%q" .Description }},
+				{{- end }}
+				{{- if .Deprecated }}
+				Deprecated: {{ printf "%q" .Deprecated }},
+				{{- end }}
+			},
+		{{- end }}
+		},
+	})
+{{- end }}
+}
+`))
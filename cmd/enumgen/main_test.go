@@ -0,0 +1,199 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("unable to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	source := `package colours
+
+// Colour is an example enum
+type Colour string
+
+const (
+	// ColourRed is red
+	ColourRed   Colour = "RED"
+	ColourGreen Colour = "GREEN" //enumgen:fallback
+)
+
+// ColourBlue is declared in a separate block
+//
+// Deprecated: use ColourRed
+// instead.
+const ColourBlue Colour = "BLUE"
+
+// ColourPlaceholder ...
+const ColourPlaceholder Colour = "PLACEHOLDER"
+
+const notAColour = "PINK"
+`
+
+	tests := []struct {
+		name      string
+		files     map[string]string
+		types     []string
+		wantErr   string
+		wantParts []string
+	}{
+		{
+			name: "Happy case: generate enum boilerplate",
+			files: map[string]string{
+				"colours.go":      source,
+				"colours_test.go": "package colours\n\nthis is ignored",
+				defaultOutput:     "this stale output is ignored",
+			},
+			types: []string{"Colour"},
+			wantParts: []string{
+				"// Code generated by enumgen. DO NOT EDIT.",
+				"package colours",
+				"var AllColour = []Colour{\n\tColourRed,\n\tColourGreen,\n\tColourBlue,\n\tColourPlaceholder,\n}",
+				"case ColourRed,\n\t\tColourGreen,\n\t\tColourBlue,\n\t\tColourPlaceholder:",
+				"return []string{\n\t\tstring(ColourRed),\n\t\tstring(ColourGreen),\n\t\tstring(ColourBlue),\n\t\tstring(ColourPlaceholder),\n\t}",
+				"func (e Colour) String() string",
+				"func (e Colour) Label(language Language) string {\n\treturn LabelOf(e.EnumName(), e.String(), language)\n}",
+				"func (e Colour) EnumName() string {\n\treturn \"Colour\"\n}",
+				"Name:        \"Colour\",\n\t\tDescription: \"Colour is an example enum\",",
+				"Name:        \"ColourRed\",\n\t\t\t\tValue:       string(ColourRed),\n\t\t\t\tDescription: \"ColourRed is red\",\n\t\t\t},",
+				"Name:  \"ColourGreen\",\n\t\t\t\tValue: string(ColourGreen),\n\t\t\t},",
+				"Description: \"ColourBlue is declared in a separate block\",\n\t\t\t\tDeprecated:  \"use ColourRed instead.\",",
+				"Name:  \"ColourPlaceholder\",\n\t\t\t\tValue: string(ColourPlaceholder),\n\t\t\t},",
+				"return newNotStringError(e.EnumName(), v, e.Values())",
+				"return unmarshalInvalid(e, str)",
+				"func (e Colour) MarshalGQL(w io.Writer)",
+				"func (e *Colour) UnmarshalJSON(data []byte) error {\n\treturn unmarshalJSON(e, data)\n}",
+				"func (e Colour) MarshalJSON() ([]byte, error) {\n\treturn marshalJSON(e)\n}",
+				"func (e *Colour) Scan(src interface{}) error {\n\treturn scanEnum(e, src)\n}",
+				"func (e Colour) Value() (driver.Value, error) {\n\treturn driverValue(e)\n}",
+				"Fallback:    string(ColourGreen),",
+			},
+		},
+		{
+			name:    "Sad case: unknown type",
+			files:   map[string]string{"colours.go": source},
+			types:   []string{"Shape"},
+			wantErr: "type Shape not found",
+		},
+		{
+			name: "Sad case: type without constants",
+			files: map[string]string{
+				"colours.go": "package colours\n\ntype Shape string\n",
+			},
+			types:   []string{"Shape"},
+			wantErr: "no constants found for Shape",
+		},
+		{
+			name: "Sad case: non string type",
+			files: map[string]string{
+				"colours.go": "package colours\n\ntype Shape int\n\nconst Circle Shape = 1\n",
+			},
+			types:   []string{"Shape"},
+			wantErr: "Shape must be declared as a string type",
+		},
+		{
+			name: "Sad case: duplicate values",
+			files: map[string]string{
+				"colours.go": "package colours\n\ntype Shape string\n\nconst (\n\tCircle Shape = \"ROUND\"\n\tRing Shape = \"ROUND\"\n)\n",
+			},
+			types:   []string{"Shape"},
+			wantErr: `Circle and Ring share the value "ROUND"`,
+		},
+		{
+			name: "Happy case: enum without a fallback",
+			files: map[string]string{
+				"shapes.go": "package shapes\n\ntype Shape string\n\nconst (\n\t//enumgen:fallback\n\tCircle Shape = \"ROUND\"\n)\n\ntype Size string\n\nconst Small Size = \"SMALL\"\n",
+			},
+			types: []string{"Shape", "Size"},
+			wantParts: []string{
+				"Name:     \"Shape\",\n\t\tFallback: string(Circle),\n\t\tValues:",
+				"Name: \"Size\",\n\t\tValues:",
+			},
+		},
+		{
+			name: "Sad case: more than one fallback",
+			files: map[string]string{
+				"shapes.go": "package shapes\n\ntype Shape string\n\nconst (\n\tCircle Shape = \"ROUND\" //enumgen:fallback\n\tSquare Shape = \"SQUARE\" //enumgen:fallback\n)\n",
+			},
+			types:   []string{"Shape"},
+			wantErr: "Circle and Square are both marked as the Shape fallback",
+		},
+		{
+			name: "Sad case: non literal value",
+			files: map[string]string{
+				"colours.go": "package colours\n\ntype Shape string\n\nconst round = \"ROUND\"\n\nconst Circle Shape = round\n",
+			},
+			types:   []string{"Shape"},
+			wantErr: "Circle must be a string literal",
+		},
+		{
+			name:    "Sad case: no go files",
+			files:   map[string]string{"README.md": "nothing to see"},
+			types:   []string{"Shape"},
+			wantErr: "no Go files found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writePackage(t, tt.files)
+
+			got, err := generate(dir, defaultOutput, tt.types)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.Nil(t, err)
+
+			for _, part := range tt.wantParts {
+				if !strings.Contains(string(got), part) {
+					t.Errorf("generate() output is missing %q:\n%s", part, got)
+				}
+			}
+			assert.NotContains(t, string(got), "notAColour")
+		})
+	}
+}
+
+// TestGenerate_UpToDate fails when enums_gen.go in the root package was not
+// regenerated after its enums changed
+func TestGenerate_UpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+
+	source, err := os.ReadFile(filepath.Join(root, "enums.go"))
+	if err != nil {
+		t.Fatalf("unable to read enums.go: %v", err)
+	}
+	var types []string
+	for _, line := range strings.Split(string(source), "\n") {
+		if directive, ok := strings.CutPrefix(line, "//go:generate go run ./cmd/enumgen -type="); ok {
+			types = strings.Split(strings.TrimSpace(directive), ",")
+			break
+		}
+	}
+	if len(types) == 0 {
+		t.Fatal("enums.go has no enumgen directive")
+	}
+
+	want, err := generate(root, defaultOutput, types)
+	assert.NoError(t, err)
+	got, err := os.ReadFile(filepath.Join(root, defaultOutput))
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "%s is out of date, run go generate ./...", defaultOutput)
+}
@@ -0,0 +1,80 @@
+// Command enumschema prints schema definitions for the enums registered in
+// enumutils, so that services do not have to keep hand-written copies in sync.
+//
+// Usage:
+//
+//	go run github.com/savannahghi/enumutils/cmd/enumschema -format=graphql > enums.graphql
+//	go run github.com/savannahghi/enumutils/cmd/enumschema -format=gqlgen >> gqlgen.yml
+//	go run github.com/savannahghi/enumutils/cmd/enumschema -format=jsonschema > enums.schema.json
+//	go run github.com/savannahghi/enumutils/cmd/enumschema -format=openapi > components.json
+//
+// The -enums flag restricts the output to a comma-separated list of enums.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/savannahghi/enumutils"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumschema: ")
+
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run parses the command line arguments and writes the requested schema
+func run(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("enumschema", flag.ContinueOnError)
+	format := flags.String("format", "graphql", "output format: graphql, gqlgen, jsonschema or openapi")
+	enums := flags.String("enums", "", "comma-separated list of enums to include; defaults to all registered enums")
+	importPath := flags.String("package", enumutils.ImportPath, "import path of the Go enum types, used by the gqlgen format")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	infos, err := selectEnums(*enums)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "graphql":
+		return enumutils.WriteGraphQLSchema(w, infos)
+	case "gqlgen":
+		return enumutils.WriteGqlgenModels(w, *importPath, infos)
+	case "jsonschema":
+		return enumutils.WriteJSONSchema(w, infos)
+	case "openapi":
+		return enumutils.WriteOpenAPIComponents(w, infos)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+// selectEnums returns the named enums in the order given, or all registered
+// enums if no names are supplied
+func selectEnums(names string) ([]enumutils.EnumInfo, error) {
+	if names == "" {
+		return enumutils.Registered(), nil
+	}
+
+	infos := []enumutils.EnumInfo{}
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		info, ok := enumutils.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown enum %q", name)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantParts []string
+		wantErr   string
+	}{
+		{
+			name: "Happy case: all enums as GraphQL",
+			args: []string{},
+			wantParts: []string{
+				"enum AddressType {\n  HOME\n  WORK\n}",
+				"enum SenderID {\n  SLADE360\n  BEWELL\n}",
+			},
+		},
+		{
+			name: "Happy case: selected enums as gqlgen models",
+			args: []string{"-format=gqlgen", "-enums=Gender, CalendarView", "-package=example.com/enums"},
+			wantParts: []string{
+				"models:\n  Gender:\n    model:\n      - example.com/enums.Gender\n  CalendarView:\n",
+			},
+		},
+		{
+			name: "Happy case: JSON schema",
+			args: []string{"-format=jsonschema", "-enums=SortOrder"},
+			wantParts: []string{
+				`"$schema": "https://json-schema.org/draft/2020-12/schema"`,
+				`"SortOrder": {`,
+			},
+		},
+		{
+			name: "Happy case: OpenAPI components",
+			args: []string{"-format=openapi", "-enums=AddressType"},
+			wantParts: []string{
+				`"components": {`,
+				`"AddressType": {`,
+				`"x-enum-varnames": [`,
+			},
+		},
+		{
+			name:    "Sad case: unknown enum",
+			args:    []string{"-enums=Colour"},
+			wantErr: `unknown enum "Colour"`,
+		},
+		{
+			name:    "Sad case: unknown format",
+			args:    []string{"-format=yaml"},
+			wantErr: `unknown format "yaml"`,
+		},
+		{
+			name:    "Sad case: unknown flag",
+			args:    []string{"-colour"},
+			wantErr: "flag provided but not defined",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			err := run(tt.args, w)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			for _, part := range tt.wantParts {
+				assert.Contains(t, w.String(), part)
+			}
+		})
+	}
+}
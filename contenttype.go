@@ -0,0 +1,313 @@
+package enumutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUnsupportedContentType is returned when a MIME type, file name or
+	// content does not match any ContentType
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+
+	// ErrContentTypeMismatch is returned when content does not match its
+	// declared ContentType
+	ErrContentTypeMismatch = errors.New("content type mismatch")
+)
+
+// ContentTypeMismatchError is returned when the content of a file is not of
+// the type it was declared as e.g a PDF uploaded as a PNG
+type ContentTypeMismatchError struct {
+	Declared ContentType
+	Detected ContentType
+}
+
+func (e *ContentTypeMismatchError) Error() string {
+	return fmt.Sprintf("content declared as %s is %s", e.Declared, e.Detected)
+}
+
+// Unwrap returns ErrContentTypeMismatch
+func (e *ContentTypeMismatchError) Unwrap() error {
+	return ErrContentTypeMismatch
+}
+
+// contentTypeInfo describes how a content type is identified
+type contentTypeInfo struct {
+	// mimeType is the canonical MIME type
+	mimeType string
+
+	// aliases are other MIME types that clients send for the content type
+	aliases []string
+
+	// extensions are the file extensions of the content type, the
+	// canonical extension first
+	extensions []string
+
+	// sniff reports whether the first bytes of some content are of the
+	// content type
+	sniff func(header []byte) bool
+
+	// weak is true for content types without a signature, such as CSV,
+	// which are only detected when no other content type matches
+	weak bool
+}
+
+// contentTypes describes each ContentType
+var contentTypes = map[ContentType]contentTypeInfo{
+	ContentTypePng: {
+		mimeType:   "image/png",
+		extensions: []string{".png"},
+		sniff:      hasPrefix("\x89PNG\r\n\x1a\n"),
+	},
+	ContentTypeJpg: {
+		mimeType:   "image/jpeg",
+		aliases:    []string{"image/jpg", "image/pjpeg"},
+		extensions: []string{".jpg", ".jpeg", ".jpe"},
+		sniff:      hasPrefix("\xff\xd8\xff"),
+	},
+	ContentTypePdf: {
+		mimeType:   "application/pdf",
+		aliases:    []string{"application/x-pdf"},
+		extensions: []string{".pdf"},
+		sniff:      hasPrefix("%PDF-"),
+	},
+	ContentTypeDocx: {
+		mimeType:   "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		extensions: []string{".docx"},
+		sniff:      isZipWith("word/"),
+	},
+	ContentTypeXlsx: {
+		mimeType:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		extensions: []string{".xlsx"},
+		sniff:      isZipWith("xl/"),
+	},
+	ContentTypeCsv: {
+		mimeType:   "text/csv",
+		aliases:    []string{"application/csv", "text/comma-separated-values"},
+		extensions: []string{".csv"},
+		sniff:      isDelimitedText,
+		weak:       true,
+	},
+	ContentTypeHeic: {
+		mimeType:   "image/heic",
+		aliases:    []string{"image/heic-sequence"},
+		extensions: []string{".heic"},
+		sniff:      isHeic,
+	},
+	ContentTypeHeif: {
+		mimeType:   "image/heif",
+		aliases:    []string{"image/heif-sequence"},
+		extensions: []string{".heif", ".hif"},
+		// generic HEIF brands are also the major brand of many HEIC images
+		sniff: func(header []byte) bool {
+			return hasBrand("mif1", "msf1", "heif")(header) && !isHeic(header)
+		},
+	},
+	ContentTypeWebp: {
+		mimeType:   "image/webp",
+		extensions: []string{".webp"},
+		sniff: func(header []byte) bool {
+			return len(header) >= 12 && string(header[:4]) == "RIFF" && string(header[8:12]) == "WEBP"
+		},
+	},
+	ContentTypeTiff: {
+		mimeType:   "image/tiff",
+		extensions: []string{".tiff", ".tif"},
+		sniff:      hasPrefix("II*\x00", "MM\x00*"),
+	},
+	ContentTypeDicom: {
+		mimeType:   "application/dicom",
+		extensions: []string{".dcm", ".dicom"},
+		// DICOM files start with a 128 byte preamble followed by "DICM"
+		sniff: func(header []byte) bool {
+			return len(header) >= 132 && string(header[128:132]) == "DICM"
+		},
+	},
+}
+
+// sniffLen is the number of bytes read to detect a content type. It is large
+// enough to reach the names of the first few entries of DOCX and XLSX files.
+const sniffLen = 8192
+
+// hasPrefix returns a sniffer that matches content starting with any of the
+// supplied signatures
+func hasPrefix(signatures ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		for _, signature := range signatures {
+			if bytes.HasPrefix(header, []byte(signature)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isZipWith returns a sniffer that matches ZIP archives, such as Office Open
+// XML documents, with an entry under the supplied directory near the start
+func isZipWith(directory string) func([]byte) bool {
+	return func(header []byte) bool {
+		return bytes.HasPrefix(header, []byte("PK\x03\x04")) && bytes.Contains(header, []byte(directory))
+	}
+}
+
+// isHeic matches HEIF images encoded with HEVC. Their major brand is often
+// the generic mif1 or msf1, with a HEVC brand among the compatible brands.
+var isHeic = hasCompatibleBrand("heic", "heix", "heim", "heis", "hevc", "hevx")
+
+// hasBrand returns a sniffer that matches ISO base media files, such as HEIF
+// images, whose major brand is one of the supplied brands
+func hasBrand(brands ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		fileBrands := ftypBrands(header)
+		return len(fileBrands) > 0 && slices.Contains(brands, fileBrands[0])
+	}
+}
+
+// hasCompatibleBrand returns a sniffer that matches ISO base media files
+// whose major brand or any compatible brand is one of the supplied brands
+func hasCompatibleBrand(brands ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		return slices.ContainsFunc(ftypBrands(header), func(brand string) bool {
+			return slices.Contains(brands, brand)
+		})
+	}
+}
+
+// ftypBrands returns the brands in the ftyp box that starts ISO base media
+// files, the major brand first followed by the compatible brands
+func ftypBrands(header []byte) []string {
+	if len(header) < 12 || string(header[4:8]) != "ftyp" {
+		return nil
+	}
+	brands := []string{string(header[8:12])}
+
+	// the box size is followed by its type, the major brand and a minor
+	// version; the compatible brands fill the rest of the box
+	end := min(int(binary.BigEndian.Uint32(header[:4])), len(header))
+	for i := 16; i+4 <= end; i += 4 {
+		brands = append(brands, string(header[i:i+4]))
+	}
+	return brands
+}
+
+// isDelimitedText matches UTF-8 text without control characters that
+// contains a comma, semicolon or tab
+func isDelimitedText(header []byte) bool {
+	header = bytes.TrimPrefix(header, []byte("\xef\xbb\xbf"))
+
+	// the header may end part way through a character
+	for i := 0; i < utf8.UTFMax-1 && len(header) > 0 && !utf8.Valid(header); i++ {
+		header = header[:len(header)-1]
+	}
+	if !utf8.Valid(header) {
+		return false
+	}
+
+	for _, b := range header {
+		if (b < 0x20 && b != '\t' && b != '\n' && b != '\r') || b == 0x7f {
+			return false
+		}
+	}
+	return bytes.ContainsAny(header, ",;\t")
+}
+
+// MIMEType returns the MIME type of the content type e.g image/png. It is
+// empty for invalid content types.
+func (e ContentType) MIMEType() string {
+	return contentTypes[e].mimeType
+}
+
+// Extensions returns the file extensions of the content type e.g [.jpg
+// .jpeg .jpe]. The first extension is the canonical one.
+func (e ContentType) Extensions() []string {
+	return append([]string(nil), contentTypes[e].extensions...)
+}
+
+// ContentTypeFromMIME returns the content type of a MIME type. Parameters and
+// case are ignored, so "IMAGE/PNG; charset=binary" is a PNG.
+func ContentTypeFromMIME(mimeType string) (ContentType, error) {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedContentType, mimeType)
+	}
+
+	for _, contentType := range AllContentType {
+		info := contentTypes[contentType]
+		if mediaType == info.mimeType {
+			return contentType, nil
+		}
+		for _, alias := range info.aliases {
+			if mediaType == alias {
+				return contentType, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedContentType, mimeType)
+}
+
+// ContentTypeFromFilename returns the content type of a file name from its
+// extension e.g "scan.JPEG" is a JPG
+func ContentTypeFromFilename(name string) (ContentType, error) {
+	extension := strings.ToLower(filepath.Ext(name))
+	if extension != "" {
+		for _, contentType := range AllContentType {
+			for _, candidate := range contentTypes[contentType].extensions {
+				if extension == candidate {
+					return contentType, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedContentType, name)
+}
+
+// DetectContentType reads the start of some content and returns its content
+// type from its magic bytes. It reads up to 8 KiB, so callers that need
+// the whole content afterwards should read it into memory first or use a
+// bufio.Reader and Peek.
+func DetectContentType(r io.Reader) (ContentType, error) {
+	header := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	return detectContentType(header[:n])
+}
+
+func detectContentType(header []byte) (ContentType, error) {
+	for _, weak := range []bool{false, true} {
+		for _, contentType := range AllContentType {
+			info := contentTypes[contentType]
+			if info.weak == weak && info.sniff(header) {
+				return contentType, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: content is not any of %s", ErrUnsupportedContentType, strings.Join(ContentType("").Values(), ", "))
+}
+
+// Verify checks that the content is of the content type. It returns a
+// ContentTypeMismatchError if the content is of another content type and
+// ErrUnsupportedContentType if it is not of any.
+func (e ContentType) Verify(r io.Reader) error {
+	if !e.IsValid() {
+		return newInvalidEnumError(e.EnumName(), e.String(), e.Values())
+	}
+
+	detected, err := DetectContentType(r)
+	if err != nil {
+		return err
+	}
+	if detected != e {
+		return &ContentTypeMismatchError{Declared: e, Detected: detected}
+	}
+	return nil
+}
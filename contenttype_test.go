@@ -0,0 +1,244 @@
+package enumutils_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// samples are the first bytes of a file of each content type
+var samples = map[enumutils.ContentType][]byte{
+	enumutils.ContentTypePng:   []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"),
+	enumutils.ContentTypeJpg:   []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00"),
+	enumutils.ContentTypePdf:   []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"),
+	enumutils.ContentTypeDocx:  zipSample("[Content_Types].xml", "_rels/.rels", "word/document.xml"),
+	enumutils.ContentTypeXlsx:  zipSample("[Content_Types].xml", "_rels/.rels", "xl/workbook.xml"),
+	enumutils.ContentTypeCsv:   []byte("\xef\xbb\xbfname,county\r\nWanjiru,Nyeri\r\nJuma,Mombasa\r\n"),
+	enumutils.ContentTypeHeic:  []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"),
+	enumutils.ContentTypeHeif:  []byte("\x00\x00\x00\x18ftypmif1\x00\x00\x00\x00mif1heif"),
+	enumutils.ContentTypeWebp:  []byte("RIFF\x24\x00\x00\x00WEBPVP8 "),
+	enumutils.ContentTypeTiff:  []byte("MM\x00*\x00\x00\x00\x08"),
+	enumutils.ContentTypeDicom: append(make([]byte, 128), "DICM\x02\x00\x00\x00"...),
+}
+
+// zipSample returns a ZIP archive with empty entries of the supplied names
+func zipSample(names ...string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			panic(err)
+		}
+		_, _ = f.Write([]byte("<?xml version=\"1.0\"?>"))
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func TestContentType_Metadata(t *testing.T) {
+	for _, contentType := range enumutils.AllContentType {
+		t.Run(contentType.String(), func(t *testing.T) {
+			assert.NotEmpty(t, contentType.MIMEType())
+			if assert.NotEmpty(t, contentType.Extensions()) {
+				for _, extension := range contentType.Extensions() {
+					got, err := enumutils.ContentTypeFromFilename("file" + extension)
+					assert.NoError(t, err)
+					assert.Equal(t, contentType, got)
+				}
+			}
+
+			got, err := enumutils.ContentTypeFromMIME(contentType.MIMEType())
+			assert.NoError(t, err)
+			assert.Equal(t, contentType, got)
+
+			if assert.Contains(t, samples, contentType) {
+				detected, err := enumutils.DetectContentType(bytes.NewReader(samples[contentType]))
+				assert.NoError(t, err)
+				assert.Equal(t, contentType, detected)
+			}
+		})
+	}
+
+	assert.Equal(t, "image/jpeg", enumutils.ContentTypeJpg.MIMEType())
+	assert.Equal(t, ".jpg", enumutils.ContentTypeJpg.Extensions()[0])
+	assert.Empty(t, enumutils.ContentType("GIF").MIMEType())
+	assert.Empty(t, enumutils.ContentType("GIF").Extensions())
+}
+
+func TestContentTypeFromMIME(t *testing.T) {
+	tests := []struct {
+		mimeType string
+		want     enumutils.ContentType
+		wantErr  bool
+	}{
+		{mimeType: "IMAGE/PNG; charset=binary", want: enumutils.ContentTypePng},
+		{mimeType: "image/jpg", want: enumutils.ContentTypeJpg},
+		{mimeType: "application/x-pdf", want: enumutils.ContentTypePdf},
+		{mimeType: "image/gif", wantErr: true},
+		{mimeType: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mimeType, func(t *testing.T) {
+			got, err := enumutils.ContentTypeFromMIME(tt.mimeType)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestContentTypeFromFilename(t *testing.T) {
+	got, err := enumutils.ContentTypeFromFilename("uploads/Scan.JPEG")
+	assert.NoError(t, err)
+	assert.Equal(t, enumutils.ContentTypeJpg, got)
+
+	for _, name := range []string{"photo.gif", "README", "archive.pdf.zip"} {
+		_, err := enumutils.ContentTypeFromFilename(name)
+		if assert.Error(t, err) {
+			assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType))
+			assert.Contains(t, err.Error(), name)
+		}
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	_, err := enumutils.DetectContentType(strings.NewReader("GIF89a"))
+	assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType))
+
+	_, err = enumutils.DetectContentType(strings.NewReader(""))
+	assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType))
+
+	readErr := errors.New("connection reset")
+	_, err = enumutils.DetectContentType(iotest.ErrReader(readErr))
+	assert.True(t, errors.Is(err, readErr))
+
+	// content is read in full even when the reader returns it in pieces
+	detected, err := enumutils.DetectContentType(iotest.OneByteReader(bytes.NewReader(samples[enumutils.ContentTypePng])))
+	assert.NoError(t, err)
+	assert.Equal(t, enumutils.ContentTypePng, detected)
+}
+
+func TestDetectContentType_Ambiguous(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+		want    enumutils.ContentType
+		wantErr bool
+	}{
+		{
+			name:    "zip that is not an office document",
+			content: zipSample("photos/1.jpg"),
+			wantErr: true,
+		},
+		{
+			name:    "iso media that is not an image",
+			content: []byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00"),
+			wantErr: true,
+		},
+		{
+			name:    "HEIC with a generic major brand",
+			content: []byte("\x00\x00\x00\x1cftypmif1\x00\x00\x00\x00mif1miafheic"),
+			want:    enumutils.ContentTypeHeic,
+		},
+		{
+			name:    "HEIC sequence with a generic major brand",
+			content: []byte("\x00\x00\x00\x18ftypmsf1\x00\x00\x00\x00hevc"),
+			want:    enumutils.ContentTypeHeic,
+		},
+		{
+			name:    "HEVC brand after the end of the ftyp box",
+			content: []byte("\x00\x00\x00\x14ftypmif1\x00\x00\x00\x00mif1heic"),
+			want:    enumutils.ContentTypeHeif,
+		},
+		{
+			name:    "iso media with an unknown major brand",
+			content: []byte("\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf"),
+			wantErr: true,
+		},
+		{
+			name:    "riff that is not webp",
+			content: []byte("RIFF\x24\x00\x00\x00WAVEfmt "),
+			wantErr: true,
+		},
+		{
+			name:    "text without delimiters",
+			content: []byte("just a note\n"),
+			wantErr: true,
+		},
+		{
+			name:    "text with control characters",
+			content: []byte("a,b\x00c"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid UTF-8",
+			content: []byte("a,b\xff\xfe,c\n"),
+			wantErr: true,
+		},
+		{
+			name:    "semicolon separated values",
+			content: []byte("name;county\nJuma;Mombasa\n"),
+			want:    enumutils.ContentTypeCsv,
+		},
+		{
+			name:    "csv cut off part way through a character",
+			content: append(bytes.Repeat([]byte("jina,kaunti\n"), 682), "Nyeri,Mūranga\n"...),
+			want:    enumutils.ContentTypeCsv,
+		},
+		{
+			name:    "DICOM that is too short",
+			content: make([]byte, 130),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.DetectContentType(bytes.NewReader(tt.content))
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType), "got %v", got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestContentType_Verify(t *testing.T) {
+	pdf := samples[enumutils.ContentTypePdf]
+
+	assert.NoError(t, enumutils.ContentTypePdf.Verify(bytes.NewReader(pdf)))
+
+	err := enumutils.ContentTypePng.Verify(bytes.NewReader(pdf))
+	assert.True(t, errors.Is(err, enumutils.ErrContentTypeMismatch))
+	var mismatch *enumutils.ContentTypeMismatchError
+	if assert.True(t, errors.As(err, &mismatch)) {
+		assert.Equal(t, enumutils.ContentTypePng, mismatch.Declared)
+		assert.Equal(t, enumutils.ContentTypePdf, mismatch.Detected)
+		assert.Equal(t, "content declared as PNG is PDF", err.Error())
+	}
+
+	err = enumutils.ContentTypePng.Verify(strings.NewReader("plain text"))
+	assert.True(t, errors.Is(err, enumutils.ErrUnsupportedContentType))
+
+	err = enumutils.ContentType("GIF").Verify(strings.NewReader("GIF89a"))
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+
+	// HEIC images from many Android phones have the generic HEIF major brand
+	heic := []byte("\x00\x00\x00\x1cftypmif1\x00\x00\x00\x00mif1miafheic")
+	assert.NoError(t, enumutils.ContentTypeHeic.Verify(bytes.NewReader(heic)))
+	_, err = enumutils.DefaultUploadPolicy().Validate(enumutils.ContentTypeHeic, enumutils.UploadPurposeAvatar, bytes.NewReader(heic))
+	assert.NoError(t, err)
+}
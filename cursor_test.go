@@ -0,0 +1,197 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var cursorKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestNewCursorCodec(t *testing.T) {
+	_, err := enumutils.NewCursorCodec([]byte("short"))
+	assert.Error(t, err)
+
+	codec, err := enumutils.NewCursorCodec(cursorKey)
+	assert.NoError(t, err)
+	assert.NotNil(t, codec)
+}
+
+func TestCursorCodec(t *testing.T) {
+	codec, err := enumutils.NewCursorCodec(cursorKey)
+	assert.NoError(t, err)
+
+	spec := enumutils.SortSpec{
+		{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+		{FieldName: "id", SortOrder: enumutils.SortOrderDesc},
+	}
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	cursor, err := codec.Encode(spec, createdAt, int64(9007199254740993))
+	assert.NoError(t, err)
+	assert.NotContains(t, cursor, "=")
+
+	keys, err := codec.Decode(spec, cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"2024-03-01T10:00:00Z", json.Number("9007199254740993")}, keys)
+
+	_, err = codec.Encode(spec, createdAt)
+	assert.Error(t, err)
+
+	_, err = codec.Encode(enumutils.SortSpec{{FieldName: "id", SortOrder: enumutils.SortOrderAsc}}, make(chan int))
+	assert.Error(t, err)
+
+	payload, signature, _ := strings.Cut(cursor, ".")
+	otherCodec, err := enumutils.NewCursorCodec([]byte(strings.Repeat("k", 32)))
+	assert.NoError(t, err)
+	otherCursor, err := otherCodec.Encode(spec, createdAt, 1)
+	assert.NoError(t, err)
+	otherPayload, _, _ := strings.Cut(otherCursor, ".")
+
+	ascending := enumutils.SortSpec{
+		{FieldName: "createdAt", SortOrder: enumutils.SortOrderAsc},
+		{FieldName: "id", SortOrder: enumutils.SortOrderAsc},
+	}
+
+	tests := []struct {
+		name    string
+		spec    enumutils.SortSpec
+		cursor  string
+		wantErr string
+	}{
+		{
+			name:    "no signature",
+			spec:    spec,
+			cursor:  payload,
+			wantErr: "invalid cursor: malformed",
+		},
+		{
+			name:    "payload is not base64",
+			spec:    spec,
+			cursor:  "!!." + signature,
+			wantErr: "invalid cursor: malformed",
+		},
+		{
+			name:    "signature is not base64",
+			spec:    spec,
+			cursor:  payload + ".!!",
+			wantErr: "invalid cursor: malformed",
+		},
+		{
+			name:    "tampered payload",
+			spec:    spec,
+			cursor:  otherPayload + "." + signature,
+			wantErr: "invalid cursor: bad signature",
+		},
+		{
+			name:    "signed with another key",
+			spec:    spec,
+			cursor:  otherCursor,
+			wantErr: "invalid cursor: bad signature",
+		},
+		{
+			name:    "different sort",
+			spec:    ascending,
+			cursor:  cursor,
+			wantErr: `invalid cursor: issued for sort "createdAt:DESC,id:DESC"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := codec.Decode(tt.spec, tt.cursor)
+			assert.True(t, errors.Is(err, enumutils.ErrInvalidCursor))
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.wantErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestSortSpec_SeekCondition(t *testing.T) {
+	columns := map[string]string{"createdAt": "created_at", "id": "id", "name": "p.name"}
+
+	tests := []struct {
+		name     string
+		spec     enumutils.SortSpec
+		keys     []interface{}
+		offset   int
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  string
+	}{
+		{
+			name:     "single key",
+			spec:     enumutils.SortSpec{{FieldName: "id", SortOrder: enumutils.SortOrderAsc}},
+			keys:     []interface{}{7},
+			wantSQL:  "id > $1",
+			wantArgs: []interface{}{7},
+		},
+		{
+			name: "descending",
+			spec: enumutils.SortSpec{
+				{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+				{FieldName: "id", SortOrder: enumutils.SortOrderDesc},
+			},
+			keys:     []interface{}{"2024-03-01T10:00:00Z", 7},
+			wantSQL:  "(created_at, id) < ($1, $2)",
+			wantArgs: []interface{}{"2024-03-01T10:00:00Z", 7},
+		},
+		{
+			name: "ascending with an offset",
+			spec: enumutils.SortSpec{
+				{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+				{FieldName: "id", SortOrder: enumutils.SortOrderAsc},
+			},
+			keys:     []interface{}{"Juma", 7},
+			offset:   2,
+			wantSQL:  "(p.name, id) > ($3, $4)",
+			wantArgs: []interface{}{"Juma", 7},
+		},
+		{
+			name: "mixed directions",
+			spec: enumutils.SortSpec{
+				{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+				{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+				{FieldName: "id", SortOrder: enumutils.SortOrderAsc},
+			},
+			keys:     []interface{}{"Juma", "2024-03-01", 7},
+			wantSQL:  "(p.name > $1 OR (p.name = $1 AND created_at < $2) OR (p.name = $1 AND created_at = $2 AND id > $3))",
+			wantArgs: []interface{}{"Juma", "2024-03-01", 7},
+		},
+		{
+			name:    "no sort",
+			spec:    enumutils.SortSpec{},
+			wantErr: "a seek condition needs at least one sort field",
+		},
+		{
+			name:    "missing keys",
+			spec:    enumutils.SortSpec{{FieldName: "id", SortOrder: enumutils.SortOrderAsc}},
+			wantErr: `a seek condition for "id:ASC" needs 1 keys, got 0`,
+		},
+		{
+			name:    "unknown field",
+			spec:    enumutils.SortSpec{{FieldName: "dob", SortOrder: enumutils.SortOrderAsc}},
+			keys:    []interface{}{"2000-01-01"},
+			wantErr: `sort[0]: unknown field "dob"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := tt.spec.SeekCondition(columns, tt.keys, tt.offset)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -1,10 +1,6 @@
 package enumutils
 
-import (
-	"fmt"
-	"io"
-	"strconv"
-)
+//go:generate go run ./cmd/enumgen -type=Gender,FieldType,Operation,SortOrder,ContentType,Language,PractitionerSpecialty,CalendarView,AddressType,IdentificationDocType,SenderID,FilterLogic,NullsOrder,UploadPurpose
 
 // Gender is a code system for administrative gender.
 //
@@ -16,7 +12,7 @@ const (
 	GenderMale           Gender = "male"
 	GenderFemale         Gender = "female"
 	GenderOther          Gender = "other"
-	GenderUnknown        Gender = "unknown"
+	GenderUnknown        Gender = "unknown" //enumgen:fallback
 	GenderNonBinary      Gender = "nonbinary"
 	GenderGenderQueer    Gender = "genderqueer"
 	GenderTransGender    Gender = "transgender"
@@ -26,35 +22,6 @@ const (
 	GenderPreferNotToSay Gender = "prefer_not_to_say"
 )
 
-// AllGender is a list of known genders
-var AllGender = []Gender{
-	GenderMale,
-	GenderFemale,
-	GenderOther,
-	GenderUnknown,
-	GenderNonBinary,
-	GenderGenderQueer,
-	GenderTransGender,
-	GenderAgender,
-	GenderBigender,
-	GenderTwoSpirit,
-	GenderPreferNotToSay,
-}
-
-// IsValid returns True if the enum value is valid
-func (e Gender) IsValid() bool {
-	switch e {
-	case GenderMale, GenderFemale, GenderOther, GenderUnknown, GenderNonBinary, GenderGenderQueer, GenderTransGender,
-		GenderAgender, GenderBigender, GenderTwoSpirit, GenderPreferNotToSay:
-		return true
-	}
-	return false
-}
-
-func (e Gender) String() string {
-	return string(e)
-}
-
 // ToAdvantageGender converts the `male` and `female` genders to `MALE` and `FEMALE` respectively.
 // This is used in support of advantage wrapper service and also ensure consistency of known gender types in go services.
 func (e Gender) ToAdvantageGender() string {
@@ -68,25 +35,6 @@ func (e Gender) ToAdvantageGender() string {
 	}
 }
 
-// UnmarshalGQL translates from the supplied value to a valid enum value
-func (e *Gender) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = Gender(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Gender", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the enum value to the supplied writer
-func (e Gender) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // FieldType is used to represent the GraphQL enum that is used for filter parameters
 type FieldType string
 
@@ -107,49 +55,6 @@ const (
 	FieldTypeString FieldType = "STRING"
 )
 
-// AllFieldType is a list of all field types, used to simulate/map to a GraphQL enum
-var AllFieldType = []FieldType{
-	FieldTypeBoolean,
-	FieldTypeTimestamp,
-	FieldTypeNumber,
-	FieldTypeInteger,
-	FieldTypeString,
-}
-
-// IsValid returns True if the supplied value is a valid field type
-func (e FieldType) IsValid() bool {
-	switch e {
-	case FieldTypeBoolean, FieldTypeTimestamp, FieldTypeNumber, FieldTypeInteger, FieldTypeString:
-		return true
-	}
-	return false
-}
-
-// String represents a GraphQL enum as a string
-func (e FieldType) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL checks whether the supplied value is a valid gqlgen enum
-// and returns an error if it is not
-func (e *FieldType) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = FieldType(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid FieldType", str)
-	}
-	return nil
-}
-
-// MarshalGQL serializes the enum value to the supplied writer
-func (e FieldType) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // Operation is used to map to a gqlgen (GraphQL) enum that defines filter/comparison operations
 type Operation string
 
@@ -178,50 +83,6 @@ const (
 	OperationContains Operation = "CONTAINS"
 )
 
-// AllOperation is a list of all valid operations for filter parameters
-var AllOperation = []Operation{
-	OperationLessThan,
-	OperationLessThanOrEqualTo,
-	OperationEqual,
-	OperationGreaterThan,
-	OperationGreaterThanOrEqualTo,
-	OperationIn,
-	OperationContains,
-}
-
-// IsValid returns true if the operation is valid
-func (e Operation) IsValid() bool {
-	switch e {
-	case OperationLessThan, OperationLessThanOrEqualTo, OperationEqual, OperationGreaterThan, OperationGreaterThanOrEqualTo, OperationIn, OperationContains:
-		return true
-	}
-	return false
-}
-
-// String renders an operation enum value as a string
-func (e Operation) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL confirms that an enum value is valid and returns an error if it is not
-func (e *Operation) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = Operation(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Operation", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the enum value to the supplied writer
-func (e Operation) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // SortOrder is used to represent map sort directions to a GraphQl enum
 type SortOrder string
 
@@ -233,103 +94,40 @@ const (
 	SortOrderDesc SortOrder = "DESC"
 )
 
-// AllSortOrder is a list of all valid sort orders
-var AllSortOrder = []SortOrder{
-	SortOrderAsc,
-	SortOrderDesc,
-}
-
-// IsValid returns true if the sort order is valid
-func (e SortOrder) IsValid() bool {
-	switch e {
-	case SortOrderAsc, SortOrderDesc:
-		return true
-	}
-	return false
-}
-
-// String renders the sort order as a plain string
-func (e SortOrder) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL confirms that the supplied value is a valid sort order
-// and returns an error if it is not
-func (e *SortOrder) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = SortOrder(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SortOrder", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the sort order to the supplied writer as a quoted string
-func (e SortOrder) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // ContentType defines accepted content types
 type ContentType string
 
 // Constants used to map to allowed MIME types
 const (
-	ContentTypePng ContentType = "PNG"
-	ContentTypeJpg ContentType = "JPG"
-	ContentTypePdf ContentType = "PDF"
+	ContentTypePng   ContentType = "PNG"
+	ContentTypeJpg   ContentType = "JPG"
+	ContentTypePdf   ContentType = "PDF"
+	ContentTypeDocx  ContentType = "DOCX"
+	ContentTypeXlsx  ContentType = "XLSX"
+	ContentTypeCsv   ContentType = "CSV"
+	ContentTypeHeic  ContentType = "HEIC"
+	ContentTypeHeif  ContentType = "HEIF"
+	ContentTypeWebp  ContentType = "WEBP"
+	ContentTypeTiff  ContentType = "TIFF"
+	ContentTypeDicom ContentType = "DICOM"
 )
 
-// AllContentType is a list of all acceptable content types
-var AllContentType = []ContentType{
-	ContentTypePng,
-	ContentTypeJpg,
-	ContentTypePdf,
-}
-
-// IsValid ensures that the content type value is valid
-func (e ContentType) IsValid() bool {
-	switch e {
-	case ContentTypePng, ContentTypeJpg, ContentTypePdf:
-		return true
-	}
-	return false
-}
-
-func (e ContentType) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL turns the supplied value into a content type value
-func (e *ContentType) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = ContentType(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ContentType", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the value of this enum to the supplied writer
-func (e ContentType) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-
-}
-
 // Language defines allowed languages for uploads
 type Language string
 
 // Constants used to map to allowed languages
 const (
-	LanguageEn Language = "en"
-	LanguageSw Language = "sw"
+	LanguageEn  Language = "en"
+	LanguageSw  Language = "sw"
+	LanguageFr  Language = "fr"
+	LanguageSo  Language = "so"
+	LanguageAm  Language = "am"
+	LanguageKi  Language = "ki"
+	LanguageLuo Language = "luo"
+	LanguageLuy Language = "luy"
+	LanguageKln Language = "kln"
+	LanguageKam Language = "kam"
+	LanguageRw  Language = "rw"
 )
 
 // LanguageCodingSystem is the FHIR language coding system
@@ -340,47 +138,50 @@ const LanguageCodingVersion = ""
 
 // LanguageNames is a map of language codes to language names
 var LanguageNames = map[Language]string{
-	LanguageEn: "English",
-	LanguageSw: "Swahili",
-}
-
-// AllLanguage is a list of all allowed languages
-var AllLanguage = []Language{
-	LanguageEn,
-	LanguageSw,
-}
-
-// IsValid ensures that the supplied language value is correct
-func (e Language) IsValid() bool {
-	switch e {
-	case LanguageEn, LanguageSw:
-		return true
-	}
-	return false
-}
-
-func (e Language) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL translates the input to a language type value
-func (e *Language) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = Language(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Language", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the value of this enum to the supplied writer
-func (e Language) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-
+	LanguageEn:  "English",
+	LanguageSw:  "Swahili",
+	LanguageFr:  "French",
+	LanguageSo:  "Somali",
+	LanguageAm:  "Amharic",
+	LanguageKi:  "Kikuyu",
+	LanguageLuo: "Luo",
+	LanguageLuy: "Luhya",
+	LanguageKln: "Kalenjin",
+	LanguageKam: "Kamba",
+	LanguageRw:  "Kinyarwanda",
+}
+
+// LanguageNativeNames is a map of language codes to the names of the
+// languages in the languages themselves
+var LanguageNativeNames = map[Language]string{
+	LanguageEn:  "English",
+	LanguageSw:  "Kiswahili",
+	LanguageFr:  "Français",
+	LanguageSo:  "Soomaali",
+	LanguageAm:  "አማርኛ",
+	LanguageKi:  "Gĩkũyũ",
+	LanguageLuo: "Dholuo",
+	LanguageLuy: "Oluluhya",
+	LanguageKln: "Kalenjin",
+	LanguageKam: "Kikamba",
+	LanguageRw:  "Ikinyarwanda",
+}
+
+// LanguageAlpha3Codes is a map of language codes to their three letter ISO
+// 639-2/T and ISO 639-3 codes, for systems that do not accept two letter
+// codes
+var LanguageAlpha3Codes = map[Language]string{
+	LanguageEn:  "eng",
+	LanguageSw:  "swa",
+	LanguageFr:  "fra",
+	LanguageSo:  "som",
+	LanguageAm:  "amh",
+	LanguageKi:  "kik",
+	LanguageLuo: "luo",
+	LanguageLuy: "luy",
+	LanguageKln: "kln",
+	LanguageKam: "kam",
+	LanguageRw:  "kin",
 }
 
 // PractitionerSpecialty is a list of recognised health worker specialties.
@@ -390,7 +191,7 @@ type PractitionerSpecialty string
 
 // list of known practitioner specialties
 const (
-	PractitionerSpecialtyUnspecified                     PractitionerSpecialty = "UNSPECIFIED"
+	PractitionerSpecialtyUnspecified                     PractitionerSpecialty = "UNSPECIFIED" //enumgen:fallback
 	PractitionerSpecialtyAnaesthesia                     PractitionerSpecialty = "ANAESTHESIA"
 	PractitionerSpecialtyCardiothoracicSurgery           PractitionerSpecialty = "CARDIOTHORACIC_SURGERY"
 	PractitionerSpecialtyClinicalMedicalGenetics         PractitionerSpecialty = "CLINICAL_MEDICAL_GENETICS"
@@ -424,75 +225,6 @@ const (
 	PractitionerSpecialtyUrology                         PractitionerSpecialty = "UROLOGY"
 )
 
-// AllPractitionerSpecialty is the set of known practitioner specialties
-var AllPractitionerSpecialty = []PractitionerSpecialty{
-	PractitionerSpecialtyUnspecified,
-	PractitionerSpecialtyAnaesthesia,
-	PractitionerSpecialtyCardiothoracicSurgery,
-	PractitionerSpecialtyClinicalMedicalGenetics,
-	PractitionerSpecialtyClincicalPathology,
-	PractitionerSpecialtyGeneralPathology,
-	PractitionerSpecialtyAnatomicPathology,
-	PractitionerSpecialtyClinicalOncology,
-	PractitionerSpecialtyDermatology,
-	PractitionerSpecialtyEarNoseAndThroat,
-	PractitionerSpecialtyEmergencyMedicine,
-	PractitionerSpecialtyFamilyMedicine,
-	PractitionerSpecialtyGeneralSurgery,
-	PractitionerSpecialtyGeriatrics,
-	PractitionerSpecialtyImmunology,
-	PractitionerSpecialtyInfectiousDisease,
-	PractitionerSpecialtyInternalMedicine,
-	PractitionerSpecialtyMicrobiology,
-	PractitionerSpecialtyNeurosurgery,
-	PractitionerSpecialtyObstetricsAndGynaecology,
-	PractitionerSpecialtyOccupationalMedicine,
-	PractitionerSpecialtyOphthalmology,
-	PractitionerSpecialtyOrthopaedicSurgery,
-	PractitionerSpecialtyOncology,
-	PractitionerSpecialtyOncologyRadiotherapy,
-	PractitionerSpecialtyPaediatricsAndChildHealth,
-	PractitionerSpecialtyPalliativeMedicine,
-	PractitionerSpecialtyPlasticAndReconstructiveSurgery,
-	PractitionerSpecialtyPsychiatry,
-	PractitionerSpecialtyPublicHealth,
-	PractitionerSpecialtyRadiology,
-	PractitionerSpecialtyUrology,
-}
-
-// IsValid returns True if the practitioner specialty is valid
-func (e PractitionerSpecialty) IsValid() bool {
-	switch e {
-	case PractitionerSpecialtyUnspecified, PractitionerSpecialtyAnaesthesia, PractitionerSpecialtyCardiothoracicSurgery, PractitionerSpecialtyClinicalMedicalGenetics, PractitionerSpecialtyClincicalPathology, PractitionerSpecialtyGeneralPathology, PractitionerSpecialtyAnatomicPathology, PractitionerSpecialtyClinicalOncology, PractitionerSpecialtyDermatology, PractitionerSpecialtyEarNoseAndThroat, PractitionerSpecialtyEmergencyMedicine, PractitionerSpecialtyFamilyMedicine, PractitionerSpecialtyGeneralSurgery, PractitionerSpecialtyGeriatrics, PractitionerSpecialtyImmunology, PractitionerSpecialtyInfectiousDisease, PractitionerSpecialtyInternalMedicine, PractitionerSpecialtyMicrobiology, PractitionerSpecialtyNeurosurgery, PractitionerSpecialtyObstetricsAndGynaecology, PractitionerSpecialtyOccupationalMedicine, PractitionerSpecialtyOphthalmology, PractitionerSpecialtyOrthopaedicSurgery, PractitionerSpecialtyOncology, PractitionerSpecialtyOncologyRadiotherapy, PractitionerSpecialtyPaediatricsAndChildHealth, PractitionerSpecialtyPalliativeMedicine, PractitionerSpecialtyPlasticAndReconstructiveSurgery, PractitionerSpecialtyPsychiatry, PractitionerSpecialtyPublicHealth, PractitionerSpecialtyRadiology, PractitionerSpecialtyUrology:
-		return true
-	}
-	return false
-}
-
-func (e PractitionerSpecialty) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL converts the supplied value to a practitioner specialty
-func (e *PractitionerSpecialty) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = PractitionerSpecialty(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid PractitionerSpecialty", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the practitioner specialty to the supplied writer
-func (e PractitionerSpecialty) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-
-}
-
 // CalendarView is used to determine what view of a calendar to render
 type CalendarView string
 
@@ -504,45 +236,6 @@ const (
 	CalendarViewWeek CalendarView = "WEEK"
 )
 
-// AllCalendarView is a list of calendar views
-var AllCalendarView = []CalendarView{
-	CalendarViewDay,
-	CalendarViewWeek,
-}
-
-// IsValid returns true if a calendar view is valid
-func (e CalendarView) IsValid() bool {
-	switch e {
-	case CalendarViewDay, CalendarViewWeek:
-		return true
-	}
-	return false
-}
-
-// String ...
-func (e CalendarView) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL converts the input value into a calendar view
-func (e *CalendarView) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = CalendarView(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CalendarView", str)
-	}
-	return nil
-}
-
-// MarshalGQL writes the calendar view value to the supplied writer
-func (e CalendarView) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // AddressType represents the types of addresses we have
 type AddressType string
 
@@ -552,44 +245,6 @@ const (
 	AddressTypeWork AddressType = "WORK"
 )
 
-// AllAddressType contains a slice of all addresses types
-var AllAddressType = []AddressType{
-	AddressTypeHome,
-	AddressTypeWork,
-}
-
-// IsValid checks if the address type is valid
-func (e AddressType) IsValid() bool {
-	switch e {
-	case AddressTypeHome, AddressTypeWork:
-		return true
-	}
-	return false
-}
-
-func (e AddressType) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL converts the input, if valid, into an address type value
-func (e *AddressType) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = AddressType(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid AddressType", str)
-	}
-	return nil
-}
-
-// MarshalGQL converts address type into a valid JSON string
-func (e AddressType) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // IdentificationDocType defines the various supplier IdentificationDocTypes
 type IdentificationDocType string
 
@@ -600,45 +255,6 @@ const (
 	IdentificationDocTypeMilitary   IdentificationDocType = "MILITARY"
 )
 
-// AllIdentificationDocType contains a slice of all IdentificationDocTypes
-var AllIdentificationDocType = []IdentificationDocType{
-	IdentificationDocTypeNationalid,
-	IdentificationDocTypePassport,
-	IdentificationDocTypeMilitary,
-}
-
-// IsValid checks if the IdentificationDocType is valid
-func (e IdentificationDocType) IsValid() bool {
-	switch e {
-	case IdentificationDocTypeNationalid, IdentificationDocTypePassport, IdentificationDocTypeMilitary:
-		return true
-	}
-	return false
-}
-
-func (e IdentificationDocType) String() string {
-	return string(e)
-}
-
-// UnmarshalGQL converts the input, if valid, into an IdentificationDocType value
-func (e *IdentificationDocType) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = IdentificationDocType(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid IdentificationDocType", str)
-	}
-	return nil
-}
-
-// MarshalGQL converts IdentificationDocType into a valid JSON string
-func (e IdentificationDocType) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // SenderID defines the various AT Sender IDs that we have and can use
 type SenderID string
 
@@ -648,40 +264,44 @@ const (
 	SenderIDBewell   SenderID = "BEWELL"
 )
 
-// AllSenderID defines a list of the sender IDs
-var AllSenderID = []SenderID{
-	SenderIDSLADE360,
-	SenderIDBewell,
-}
+// FilterLogic is used to combine the filters and groups of a FilterSet
+type FilterLogic string
 
-// IsValid checks if a Sender ID is valid
-func (e SenderID) IsValid() bool {
-	switch e {
-	case SenderIDSLADE360, SenderIDBewell:
-		return true
-	}
-	return false
-}
+const (
+	// FilterLogicAnd matches when all the filters match
+	FilterLogicAnd FilterLogic = "AND"
 
-func (e SenderID) String() string {
-	return string(e)
-}
+	// FilterLogicOr matches when any of the filters match
+	FilterLogicOr FilterLogic = "OR"
+)
 
-// UnmarshalGQL converts the input, if valid, into a Sender ID value
-func (e *SenderID) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
+// NullsOrder is used to place missing values before or after the other values
+// of a sort
+type NullsOrder string
 
-	*e = SenderID(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SenderID", str)
-	}
-	return nil
-}
+const (
+	// NullsOrderFirst sorts missing values before the other values
+	NullsOrderFirst NullsOrder = "NULLS_FIRST"
 
-// MarshalGQL converts SenderID into a valid JSON string
-func (e SenderID) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
+	// NullsOrderLast sorts missing values after the other values
+	NullsOrderLast NullsOrder = "NULLS_LAST"
+)
+
+// UploadPurpose is what an uploaded file will be used for
+type UploadPurpose string
+
+const (
+	// UploadPurposeAvatar is a profile picture
+	UploadPurposeAvatar UploadPurpose = "AVATAR"
+
+	// UploadPurposeClinicalDocument is a document added to a patient's record
+	// e.g a referral letter or a scan
+	UploadPurposeClinicalDocument UploadPurpose = "CLINICAL_DOCUMENT"
+
+	// UploadPurposeLabReport is the result of a laboratory test
+	UploadPurposeLabReport UploadPurpose = "LAB_REPORT"
+
+	// UploadPurposeIdentificationDocument is a copy of an identification
+	// document e.g a national ID or passport
+	UploadPurposeIdentificationDocument UploadPurpose = "IDENTIFICATION_DOCUMENT"
+)
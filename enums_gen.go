@@ -0,0 +1,1801 @@
+// Code generated by enumgen. DO NOT EDIT.
+
+package enumutils
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// AllGender is a list of all valid Gender values
+var AllGender = []Gender{
+	GenderMale,
+	GenderFemale,
+	GenderOther,
+	GenderUnknown,
+	GenderNonBinary,
+	GenderGenderQueer,
+	GenderTransGender,
+	GenderAgender,
+	GenderBigender,
+	GenderTwoSpirit,
+	GenderPreferNotToSay,
+}
+
+// IsValid returns true if the Gender value is valid
+func (e Gender) IsValid() bool {
+	switch e {
+	case GenderMale,
+		GenderFemale,
+		GenderOther,
+		GenderUnknown,
+		GenderNonBinary,
+		GenderGenderQueer,
+		GenderTransGender,
+		GenderAgender,
+		GenderBigender,
+		GenderTwoSpirit,
+		GenderPreferNotToSay:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid Gender values as plain strings
+func (e Gender) Values() []string {
+	return []string{
+		string(GenderMale),
+		string(GenderFemale),
+		string(GenderOther),
+		string(GenderUnknown),
+		string(GenderNonBinary),
+		string(GenderGenderQueer),
+		string(GenderTransGender),
+		string(GenderAgender),
+		string(GenderBigender),
+		string(GenderTwoSpirit),
+		string(GenderPreferNotToSay),
+	}
+}
+
+// EnumName returns the name under which Gender is registered
+func (e Gender) EnumName() string {
+	return "Gender"
+}
+
+// String renders the Gender value as a plain string
+func (e Gender) String() string {
+	return string(e)
+}
+
+// Label returns the Gender value as text for people to read in the supplied language
+func (e Gender) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a Gender value
+func (e *Gender) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = Gender(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the Gender value to the supplied writer as a quoted string
+func (e Gender) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a Gender value
+func (e *Gender) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the Gender value, if valid, as a JSON string and the zero value as null
+func (e Gender) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a Gender value
+func (e *Gender) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the Gender value before it is written to the database
+func (e Gender) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllFieldType is a list of all valid FieldType values
+var AllFieldType = []FieldType{
+	FieldTypeBoolean,
+	FieldTypeTimestamp,
+	FieldTypeNumber,
+	FieldTypeInteger,
+	FieldTypeString,
+}
+
+// IsValid returns true if the FieldType value is valid
+func (e FieldType) IsValid() bool {
+	switch e {
+	case FieldTypeBoolean,
+		FieldTypeTimestamp,
+		FieldTypeNumber,
+		FieldTypeInteger,
+		FieldTypeString:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid FieldType values as plain strings
+func (e FieldType) Values() []string {
+	return []string{
+		string(FieldTypeBoolean),
+		string(FieldTypeTimestamp),
+		string(FieldTypeNumber),
+		string(FieldTypeInteger),
+		string(FieldTypeString),
+	}
+}
+
+// EnumName returns the name under which FieldType is registered
+func (e FieldType) EnumName() string {
+	return "FieldType"
+}
+
+// String renders the FieldType value as a plain string
+func (e FieldType) String() string {
+	return string(e)
+}
+
+// Label returns the FieldType value as text for people to read in the supplied language
+func (e FieldType) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a FieldType value
+func (e *FieldType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = FieldType(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the FieldType value to the supplied writer as a quoted string
+func (e FieldType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a FieldType value
+func (e *FieldType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the FieldType value, if valid, as a JSON string and the zero value as null
+func (e FieldType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a FieldType value
+func (e *FieldType) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the FieldType value before it is written to the database
+func (e FieldType) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllOperation is a list of all valid Operation values
+var AllOperation = []Operation{
+	OperationLessThan,
+	OperationLessThanOrEqualTo,
+	OperationEqual,
+	OperationGreaterThan,
+	OperationGreaterThanOrEqualTo,
+	OperationIn,
+	OperationContains,
+}
+
+// IsValid returns true if the Operation value is valid
+func (e Operation) IsValid() bool {
+	switch e {
+	case OperationLessThan,
+		OperationLessThanOrEqualTo,
+		OperationEqual,
+		OperationGreaterThan,
+		OperationGreaterThanOrEqualTo,
+		OperationIn,
+		OperationContains:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid Operation values as plain strings
+func (e Operation) Values() []string {
+	return []string{
+		string(OperationLessThan),
+		string(OperationLessThanOrEqualTo),
+		string(OperationEqual),
+		string(OperationGreaterThan),
+		string(OperationGreaterThanOrEqualTo),
+		string(OperationIn),
+		string(OperationContains),
+	}
+}
+
+// EnumName returns the name under which Operation is registered
+func (e Operation) EnumName() string {
+	return "Operation"
+}
+
+// String renders the Operation value as a plain string
+func (e Operation) String() string {
+	return string(e)
+}
+
+// Label returns the Operation value as text for people to read in the supplied language
+func (e Operation) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a Operation value
+func (e *Operation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = Operation(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the Operation value to the supplied writer as a quoted string
+func (e Operation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a Operation value
+func (e *Operation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the Operation value, if valid, as a JSON string and the zero value as null
+func (e Operation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a Operation value
+func (e *Operation) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the Operation value before it is written to the database
+func (e Operation) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllSortOrder is a list of all valid SortOrder values
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+// IsValid returns true if the SortOrder value is valid
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderAsc,
+		SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid SortOrder values as plain strings
+func (e SortOrder) Values() []string {
+	return []string{
+		string(SortOrderAsc),
+		string(SortOrderDesc),
+	}
+}
+
+// EnumName returns the name under which SortOrder is registered
+func (e SortOrder) EnumName() string {
+	return "SortOrder"
+}
+
+// String renders the SortOrder value as a plain string
+func (e SortOrder) String() string {
+	return string(e)
+}
+
+// Label returns the SortOrder value as text for people to read in the supplied language
+func (e SortOrder) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a SortOrder value
+func (e *SortOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = SortOrder(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SortOrder value to the supplied writer as a quoted string
+func (e SortOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a SortOrder value
+func (e *SortOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the SortOrder value, if valid, as a JSON string and the zero value as null
+func (e SortOrder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a SortOrder value
+func (e *SortOrder) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the SortOrder value before it is written to the database
+func (e SortOrder) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllContentType is a list of all valid ContentType values
+var AllContentType = []ContentType{
+	ContentTypePng,
+	ContentTypeJpg,
+	ContentTypePdf,
+	ContentTypeDocx,
+	ContentTypeXlsx,
+	ContentTypeCsv,
+	ContentTypeHeic,
+	ContentTypeHeif,
+	ContentTypeWebp,
+	ContentTypeTiff,
+	ContentTypeDicom,
+}
+
+// IsValid returns true if the ContentType value is valid
+func (e ContentType) IsValid() bool {
+	switch e {
+	case ContentTypePng,
+		ContentTypeJpg,
+		ContentTypePdf,
+		ContentTypeDocx,
+		ContentTypeXlsx,
+		ContentTypeCsv,
+		ContentTypeHeic,
+		ContentTypeHeif,
+		ContentTypeWebp,
+		ContentTypeTiff,
+		ContentTypeDicom:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid ContentType values as plain strings
+func (e ContentType) Values() []string {
+	return []string{
+		string(ContentTypePng),
+		string(ContentTypeJpg),
+		string(ContentTypePdf),
+		string(ContentTypeDocx),
+		string(ContentTypeXlsx),
+		string(ContentTypeCsv),
+		string(ContentTypeHeic),
+		string(ContentTypeHeif),
+		string(ContentTypeWebp),
+		string(ContentTypeTiff),
+		string(ContentTypeDicom),
+	}
+}
+
+// EnumName returns the name under which ContentType is registered
+func (e ContentType) EnumName() string {
+	return "ContentType"
+}
+
+// String renders the ContentType value as a plain string
+func (e ContentType) String() string {
+	return string(e)
+}
+
+// Label returns the ContentType value as text for people to read in the supplied language
+func (e ContentType) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a ContentType value
+func (e *ContentType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = ContentType(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the ContentType value to the supplied writer as a quoted string
+func (e ContentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a ContentType value
+func (e *ContentType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the ContentType value, if valid, as a JSON string and the zero value as null
+func (e ContentType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a ContentType value
+func (e *ContentType) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the ContentType value before it is written to the database
+func (e ContentType) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllLanguage is a list of all valid Language values
+var AllLanguage = []Language{
+	LanguageEn,
+	LanguageSw,
+	LanguageFr,
+	LanguageSo,
+	LanguageAm,
+	LanguageKi,
+	LanguageLuo,
+	LanguageLuy,
+	LanguageKln,
+	LanguageKam,
+	LanguageRw,
+}
+
+// IsValid returns true if the Language value is valid
+func (e Language) IsValid() bool {
+	switch e {
+	case LanguageEn,
+		LanguageSw,
+		LanguageFr,
+		LanguageSo,
+		LanguageAm,
+		LanguageKi,
+		LanguageLuo,
+		LanguageLuy,
+		LanguageKln,
+		LanguageKam,
+		LanguageRw:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid Language values as plain strings
+func (e Language) Values() []string {
+	return []string{
+		string(LanguageEn),
+		string(LanguageSw),
+		string(LanguageFr),
+		string(LanguageSo),
+		string(LanguageAm),
+		string(LanguageKi),
+		string(LanguageLuo),
+		string(LanguageLuy),
+		string(LanguageKln),
+		string(LanguageKam),
+		string(LanguageRw),
+	}
+}
+
+// EnumName returns the name under which Language is registered
+func (e Language) EnumName() string {
+	return "Language"
+}
+
+// String renders the Language value as a plain string
+func (e Language) String() string {
+	return string(e)
+}
+
+// Label returns the Language value as text for people to read in the supplied language
+func (e Language) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a Language value
+func (e *Language) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = Language(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the Language value to the supplied writer as a quoted string
+func (e Language) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a Language value
+func (e *Language) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the Language value, if valid, as a JSON string and the zero value as null
+func (e Language) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a Language value
+func (e *Language) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the Language value before it is written to the database
+func (e Language) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllPractitionerSpecialty is a list of all valid PractitionerSpecialty values
+var AllPractitionerSpecialty = []PractitionerSpecialty{
+	PractitionerSpecialtyUnspecified,
+	PractitionerSpecialtyAnaesthesia,
+	PractitionerSpecialtyCardiothoracicSurgery,
+	PractitionerSpecialtyClinicalMedicalGenetics,
+	PractitionerSpecialtyClincicalPathology,
+	PractitionerSpecialtyGeneralPathology,
+	PractitionerSpecialtyAnatomicPathology,
+	PractitionerSpecialtyClinicalOncology,
+	PractitionerSpecialtyDermatology,
+	PractitionerSpecialtyEarNoseAndThroat,
+	PractitionerSpecialtyEmergencyMedicine,
+	PractitionerSpecialtyFamilyMedicine,
+	PractitionerSpecialtyGeneralSurgery,
+	PractitionerSpecialtyGeriatrics,
+	PractitionerSpecialtyImmunology,
+	PractitionerSpecialtyInfectiousDisease,
+	PractitionerSpecialtyInternalMedicine,
+	PractitionerSpecialtyMicrobiology,
+	PractitionerSpecialtyNeurosurgery,
+	PractitionerSpecialtyObstetricsAndGynaecology,
+	PractitionerSpecialtyOccupationalMedicine,
+	PractitionerSpecialtyOphthalmology,
+	PractitionerSpecialtyOrthopaedicSurgery,
+	PractitionerSpecialtyOncology,
+	PractitionerSpecialtyOncologyRadiotherapy,
+	PractitionerSpecialtyPaediatricsAndChildHealth,
+	PractitionerSpecialtyPalliativeMedicine,
+	PractitionerSpecialtyPlasticAndReconstructiveSurgery,
+	PractitionerSpecialtyPsychiatry,
+	PractitionerSpecialtyPublicHealth,
+	PractitionerSpecialtyRadiology,
+	PractitionerSpecialtyUrology,
+}
+
+// IsValid returns true if the PractitionerSpecialty value is valid
+func (e PractitionerSpecialty) IsValid() bool {
+	switch e {
+	case PractitionerSpecialtyUnspecified,
+		PractitionerSpecialtyAnaesthesia,
+		PractitionerSpecialtyCardiothoracicSurgery,
+		PractitionerSpecialtyClinicalMedicalGenetics,
+		PractitionerSpecialtyClincicalPathology,
+		PractitionerSpecialtyGeneralPathology,
+		PractitionerSpecialtyAnatomicPathology,
+		PractitionerSpecialtyClinicalOncology,
+		PractitionerSpecialtyDermatology,
+		PractitionerSpecialtyEarNoseAndThroat,
+		PractitionerSpecialtyEmergencyMedicine,
+		PractitionerSpecialtyFamilyMedicine,
+		PractitionerSpecialtyGeneralSurgery,
+		PractitionerSpecialtyGeriatrics,
+		PractitionerSpecialtyImmunology,
+		PractitionerSpecialtyInfectiousDisease,
+		PractitionerSpecialtyInternalMedicine,
+		PractitionerSpecialtyMicrobiology,
+		PractitionerSpecialtyNeurosurgery,
+		PractitionerSpecialtyObstetricsAndGynaecology,
+		PractitionerSpecialtyOccupationalMedicine,
+		PractitionerSpecialtyOphthalmology,
+		PractitionerSpecialtyOrthopaedicSurgery,
+		PractitionerSpecialtyOncology,
+		PractitionerSpecialtyOncologyRadiotherapy,
+		PractitionerSpecialtyPaediatricsAndChildHealth,
+		PractitionerSpecialtyPalliativeMedicine,
+		PractitionerSpecialtyPlasticAndReconstructiveSurgery,
+		PractitionerSpecialtyPsychiatry,
+		PractitionerSpecialtyPublicHealth,
+		PractitionerSpecialtyRadiology,
+		PractitionerSpecialtyUrology:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid PractitionerSpecialty values as plain strings
+func (e PractitionerSpecialty) Values() []string {
+	return []string{
+		string(PractitionerSpecialtyUnspecified),
+		string(PractitionerSpecialtyAnaesthesia),
+		string(PractitionerSpecialtyCardiothoracicSurgery),
+		string(PractitionerSpecialtyClinicalMedicalGenetics),
+		string(PractitionerSpecialtyClincicalPathology),
+		string(PractitionerSpecialtyGeneralPathology),
+		string(PractitionerSpecialtyAnatomicPathology),
+		string(PractitionerSpecialtyClinicalOncology),
+		string(PractitionerSpecialtyDermatology),
+		string(PractitionerSpecialtyEarNoseAndThroat),
+		string(PractitionerSpecialtyEmergencyMedicine),
+		string(PractitionerSpecialtyFamilyMedicine),
+		string(PractitionerSpecialtyGeneralSurgery),
+		string(PractitionerSpecialtyGeriatrics),
+		string(PractitionerSpecialtyImmunology),
+		string(PractitionerSpecialtyInfectiousDisease),
+		string(PractitionerSpecialtyInternalMedicine),
+		string(PractitionerSpecialtyMicrobiology),
+		string(PractitionerSpecialtyNeurosurgery),
+		string(PractitionerSpecialtyObstetricsAndGynaecology),
+		string(PractitionerSpecialtyOccupationalMedicine),
+		string(PractitionerSpecialtyOphthalmology),
+		string(PractitionerSpecialtyOrthopaedicSurgery),
+		string(PractitionerSpecialtyOncology),
+		string(PractitionerSpecialtyOncologyRadiotherapy),
+		string(PractitionerSpecialtyPaediatricsAndChildHealth),
+		string(PractitionerSpecialtyPalliativeMedicine),
+		string(PractitionerSpecialtyPlasticAndReconstructiveSurgery),
+		string(PractitionerSpecialtyPsychiatry),
+		string(PractitionerSpecialtyPublicHealth),
+		string(PractitionerSpecialtyRadiology),
+		string(PractitionerSpecialtyUrology),
+	}
+}
+
+// EnumName returns the name under which PractitionerSpecialty is registered
+func (e PractitionerSpecialty) EnumName() string {
+	return "PractitionerSpecialty"
+}
+
+// String renders the PractitionerSpecialty value as a plain string
+func (e PractitionerSpecialty) String() string {
+	return string(e)
+}
+
+// Label returns the PractitionerSpecialty value as text for people to read in the supplied language
+func (e PractitionerSpecialty) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a PractitionerSpecialty value
+func (e *PractitionerSpecialty) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = PractitionerSpecialty(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the PractitionerSpecialty value to the supplied writer as a quoted string
+func (e PractitionerSpecialty) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a PractitionerSpecialty value
+func (e *PractitionerSpecialty) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the PractitionerSpecialty value, if valid, as a JSON string and the zero value as null
+func (e PractitionerSpecialty) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a PractitionerSpecialty value
+func (e *PractitionerSpecialty) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the PractitionerSpecialty value before it is written to the database
+func (e PractitionerSpecialty) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllCalendarView is a list of all valid CalendarView values
+var AllCalendarView = []CalendarView{
+	CalendarViewDay,
+	CalendarViewWeek,
+}
+
+// IsValid returns true if the CalendarView value is valid
+func (e CalendarView) IsValid() bool {
+	switch e {
+	case CalendarViewDay,
+		CalendarViewWeek:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid CalendarView values as plain strings
+func (e CalendarView) Values() []string {
+	return []string{
+		string(CalendarViewDay),
+		string(CalendarViewWeek),
+	}
+}
+
+// EnumName returns the name under which CalendarView is registered
+func (e CalendarView) EnumName() string {
+	return "CalendarView"
+}
+
+// String renders the CalendarView value as a plain string
+func (e CalendarView) String() string {
+	return string(e)
+}
+
+// Label returns the CalendarView value as text for people to read in the supplied language
+func (e CalendarView) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a CalendarView value
+func (e *CalendarView) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = CalendarView(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the CalendarView value to the supplied writer as a quoted string
+func (e CalendarView) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a CalendarView value
+func (e *CalendarView) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the CalendarView value, if valid, as a JSON string and the zero value as null
+func (e CalendarView) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a CalendarView value
+func (e *CalendarView) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the CalendarView value before it is written to the database
+func (e CalendarView) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllAddressType is a list of all valid AddressType values
+var AllAddressType = []AddressType{
+	AddressTypeHome,
+	AddressTypeWork,
+}
+
+// IsValid returns true if the AddressType value is valid
+func (e AddressType) IsValid() bool {
+	switch e {
+	case AddressTypeHome,
+		AddressTypeWork:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid AddressType values as plain strings
+func (e AddressType) Values() []string {
+	return []string{
+		string(AddressTypeHome),
+		string(AddressTypeWork),
+	}
+}
+
+// EnumName returns the name under which AddressType is registered
+func (e AddressType) EnumName() string {
+	return "AddressType"
+}
+
+// String renders the AddressType value as a plain string
+func (e AddressType) String() string {
+	return string(e)
+}
+
+// Label returns the AddressType value as text for people to read in the supplied language
+func (e AddressType) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a AddressType value
+func (e *AddressType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = AddressType(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the AddressType value to the supplied writer as a quoted string
+func (e AddressType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a AddressType value
+func (e *AddressType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the AddressType value, if valid, as a JSON string and the zero value as null
+func (e AddressType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a AddressType value
+func (e *AddressType) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the AddressType value before it is written to the database
+func (e AddressType) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllIdentificationDocType is a list of all valid IdentificationDocType values
+var AllIdentificationDocType = []IdentificationDocType{
+	IdentificationDocTypeNationalid,
+	IdentificationDocTypePassport,
+	IdentificationDocTypeMilitary,
+}
+
+// IsValid returns true if the IdentificationDocType value is valid
+func (e IdentificationDocType) IsValid() bool {
+	switch e {
+	case IdentificationDocTypeNationalid,
+		IdentificationDocTypePassport,
+		IdentificationDocTypeMilitary:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid IdentificationDocType values as plain strings
+func (e IdentificationDocType) Values() []string {
+	return []string{
+		string(IdentificationDocTypeNationalid),
+		string(IdentificationDocTypePassport),
+		string(IdentificationDocTypeMilitary),
+	}
+}
+
+// EnumName returns the name under which IdentificationDocType is registered
+func (e IdentificationDocType) EnumName() string {
+	return "IdentificationDocType"
+}
+
+// String renders the IdentificationDocType value as a plain string
+func (e IdentificationDocType) String() string {
+	return string(e)
+}
+
+// Label returns the IdentificationDocType value as text for people to read in the supplied language
+func (e IdentificationDocType) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a IdentificationDocType value
+func (e *IdentificationDocType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = IdentificationDocType(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the IdentificationDocType value to the supplied writer as a quoted string
+func (e IdentificationDocType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a IdentificationDocType value
+func (e *IdentificationDocType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the IdentificationDocType value, if valid, as a JSON string and the zero value as null
+func (e IdentificationDocType) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a IdentificationDocType value
+func (e *IdentificationDocType) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the IdentificationDocType value before it is written to the database
+func (e IdentificationDocType) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllSenderID is a list of all valid SenderID values
+var AllSenderID = []SenderID{
+	SenderIDSLADE360,
+	SenderIDBewell,
+}
+
+// IsValid returns true if the SenderID value is valid
+func (e SenderID) IsValid() bool {
+	switch e {
+	case SenderIDSLADE360,
+		SenderIDBewell:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid SenderID values as plain strings
+func (e SenderID) Values() []string {
+	return []string{
+		string(SenderIDSLADE360),
+		string(SenderIDBewell),
+	}
+}
+
+// EnumName returns the name under which SenderID is registered
+func (e SenderID) EnumName() string {
+	return "SenderID"
+}
+
+// String renders the SenderID value as a plain string
+func (e SenderID) String() string {
+	return string(e)
+}
+
+// Label returns the SenderID value as text for people to read in the supplied language
+func (e SenderID) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a SenderID value
+func (e *SenderID) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = SenderID(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the SenderID value to the supplied writer as a quoted string
+func (e SenderID) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a SenderID value
+func (e *SenderID) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the SenderID value, if valid, as a JSON string and the zero value as null
+func (e SenderID) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a SenderID value
+func (e *SenderID) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the SenderID value before it is written to the database
+func (e SenderID) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllFilterLogic is a list of all valid FilterLogic values
+var AllFilterLogic = []FilterLogic{
+	FilterLogicAnd,
+	FilterLogicOr,
+}
+
+// IsValid returns true if the FilterLogic value is valid
+func (e FilterLogic) IsValid() bool {
+	switch e {
+	case FilterLogicAnd,
+		FilterLogicOr:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid FilterLogic values as plain strings
+func (e FilterLogic) Values() []string {
+	return []string{
+		string(FilterLogicAnd),
+		string(FilterLogicOr),
+	}
+}
+
+// EnumName returns the name under which FilterLogic is registered
+func (e FilterLogic) EnumName() string {
+	return "FilterLogic"
+}
+
+// String renders the FilterLogic value as a plain string
+func (e FilterLogic) String() string {
+	return string(e)
+}
+
+// Label returns the FilterLogic value as text for people to read in the supplied language
+func (e FilterLogic) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a FilterLogic value
+func (e *FilterLogic) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = FilterLogic(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the FilterLogic value to the supplied writer as a quoted string
+func (e FilterLogic) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a FilterLogic value
+func (e *FilterLogic) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the FilterLogic value, if valid, as a JSON string and the zero value as null
+func (e FilterLogic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a FilterLogic value
+func (e *FilterLogic) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the FilterLogic value before it is written to the database
+func (e FilterLogic) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllNullsOrder is a list of all valid NullsOrder values
+var AllNullsOrder = []NullsOrder{
+	NullsOrderFirst,
+	NullsOrderLast,
+}
+
+// IsValid returns true if the NullsOrder value is valid
+func (e NullsOrder) IsValid() bool {
+	switch e {
+	case NullsOrderFirst,
+		NullsOrderLast:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid NullsOrder values as plain strings
+func (e NullsOrder) Values() []string {
+	return []string{
+		string(NullsOrderFirst),
+		string(NullsOrderLast),
+	}
+}
+
+// EnumName returns the name under which NullsOrder is registered
+func (e NullsOrder) EnumName() string {
+	return "NullsOrder"
+}
+
+// String renders the NullsOrder value as a plain string
+func (e NullsOrder) String() string {
+	return string(e)
+}
+
+// Label returns the NullsOrder value as text for people to read in the supplied language
+func (e NullsOrder) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a NullsOrder value
+func (e *NullsOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = NullsOrder(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the NullsOrder value to the supplied writer as a quoted string
+func (e NullsOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a NullsOrder value
+func (e *NullsOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the NullsOrder value, if valid, as a JSON string and the zero value as null
+func (e NullsOrder) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a NullsOrder value
+func (e *NullsOrder) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the NullsOrder value before it is written to the database
+func (e NullsOrder) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+// AllUploadPurpose is a list of all valid UploadPurpose values
+var AllUploadPurpose = []UploadPurpose{
+	UploadPurposeAvatar,
+	UploadPurposeClinicalDocument,
+	UploadPurposeLabReport,
+	UploadPurposeIdentificationDocument,
+}
+
+// IsValid returns true if the UploadPurpose value is valid
+func (e UploadPurpose) IsValid() bool {
+	switch e {
+	case UploadPurposeAvatar,
+		UploadPurposeClinicalDocument,
+		UploadPurposeLabReport,
+		UploadPurposeIdentificationDocument:
+		return true
+	}
+	return false
+}
+
+// Values returns all valid UploadPurpose values as plain strings
+func (e UploadPurpose) Values() []string {
+	return []string{
+		string(UploadPurposeAvatar),
+		string(UploadPurposeClinicalDocument),
+		string(UploadPurposeLabReport),
+		string(UploadPurposeIdentificationDocument),
+	}
+}
+
+// EnumName returns the name under which UploadPurpose is registered
+func (e UploadPurpose) EnumName() string {
+	return "UploadPurpose"
+}
+
+// String renders the UploadPurpose value as a plain string
+func (e UploadPurpose) String() string {
+	return string(e)
+}
+
+// Label returns the UploadPurpose value as text for people to read in the supplied language
+func (e UploadPurpose) Label(language Language) string {
+	return LabelOf(e.EnumName(), e.String(), language)
+}
+
+// UnmarshalGQL converts the supplied value, if valid, into a UploadPurpose value
+func (e *UploadPurpose) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError(e.EnumName(), v, e.Values())
+	}
+
+	*e = UploadPurpose(str)
+	if !e.IsValid() {
+		return unmarshalInvalid(e, str)
+	}
+	return nil
+}
+
+// MarshalGQL writes the UploadPurpose value to the supplied writer as a quoted string
+func (e UploadPurpose) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalJSON converts the supplied JSON value, if valid, into a UploadPurpose value
+func (e *UploadPurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(e, data)
+}
+
+// MarshalJSON renders the UploadPurpose value, if valid, as a JSON string and the zero value as null
+func (e UploadPurpose) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+// Scan converts a value read from the database, if valid, into a UploadPurpose value
+func (e *UploadPurpose) Scan(src interface{}) error {
+	return scanEnum(e, src)
+}
+
+// Value validates the UploadPurpose value before it is written to the database
+func (e UploadPurpose) Value() (driver.Value, error) {
+	return driverValue(e)
+}
+
+func init() {
+	Register(EnumInfo{
+		Name:        "Gender",
+		Description: "Gender is a code system for administrative gender.\n\nSee: https://www.hl7.org/fhir/valueset-administrative-gender.html",
+		Fallback:    string(GenderUnknown),
+		Values: []EnumValue{
+			{
+				Name:  "GenderMale",
+				Value: string(GenderMale),
+			},
+			{
+				Name:  "GenderFemale",
+				Value: string(GenderFemale),
+			},
+			{
+				Name:  "GenderOther",
+				Value: string(GenderOther),
+			},
+			{
+				Name:  "GenderUnknown",
+				Value: string(GenderUnknown),
+			},
+			{
+				Name:  "GenderNonBinary",
+				Value: string(GenderNonBinary),
+			},
+			{
+				Name:  "GenderGenderQueer",
+				Value: string(GenderGenderQueer),
+			},
+			{
+				Name:  "GenderTransGender",
+				Value: string(GenderTransGender),
+			},
+			{
+				Name:  "GenderAgender",
+				Value: string(GenderAgender),
+			},
+			{
+				Name:  "GenderBigender",
+				Value: string(GenderBigender),
+			},
+			{
+				Name:  "GenderTwoSpirit",
+				Value: string(GenderTwoSpirit),
+			},
+			{
+				Name:  "GenderPreferNotToSay",
+				Value: string(GenderPreferNotToSay),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "FieldType",
+		Description: "FieldType is used to represent the GraphQL enum that is used for filter parameters",
+		Values: []EnumValue{
+			{
+				Name:        "FieldTypeBoolean",
+				Value:       string(FieldTypeBoolean),
+				Description: "FieldTypeBoolean represents a boolean filter parameter",
+			},
+			{
+				Name:        "FieldTypeTimestamp",
+				Value:       string(FieldTypeTimestamp),
+				Description: "FieldTypeTimestamp represents a timestamp filter parameter",
+			},
+			{
+				Name:        "FieldTypeNumber",
+				Value:       string(FieldTypeNumber),
+				Description: "FieldTypeNumber represents a numeric (decimal or float) filter parameter",
+			},
+			{
+				Name:        "FieldTypeInteger",
+				Value:       string(FieldTypeInteger),
+				Description: "FieldTypeInteger represents an integer filter parameter",
+			},
+			{
+				Name:        "FieldTypeString",
+				Value:       string(FieldTypeString),
+				Description: "FieldTypeString represents a string filter parameter",
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "Operation",
+		Description: "Operation is used to map to a gqlgen (GraphQL) enum that defines filter/comparison operations",
+		Values: []EnumValue{
+			{
+				Name:        "OperationLessThan",
+				Value:       string(OperationLessThan),
+				Description: "OperationLessThan represents < in a GraphQL enum",
+			},
+			{
+				Name:        "OperationLessThanOrEqualTo",
+				Value:       string(OperationLessThanOrEqualTo),
+				Description: "OperationLessThanOrEqualTo represents <= in a GraphQL enum",
+			},
+			{
+				Name:        "OperationEqual",
+				Value:       string(OperationEqual),
+				Description: "OperationEqual represents = in a GraphQL enum",
+			},
+			{
+				Name:        "OperationGreaterThan",
+				Value:       string(OperationGreaterThan),
+				Description: "OperationGreaterThan represents > in a GraphQL enum",
+			},
+			{
+				Name:        "OperationGreaterThanOrEqualTo",
+				Value:       string(OperationGreaterThanOrEqualTo),
+				Description: "OperationGreaterThanOrEqualTo represents >= in a GraphQL enum",
+			},
+			{
+				Name:        "OperationIn",
+				Value:       string(OperationIn),
+				Description: "OperationIn represents \"in\" (for queries that supply a list of parameters)\nin a GraphQL enum",
+			},
+			{
+				Name:        "OperationContains",
+				Value:       string(OperationContains),
+				Description: "OperationContains represents \"contains\" (for queries that check that a fragment is contained)\nin a field(s) in a GraphQL enum",
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "SortOrder",
+		Description: "SortOrder is used to represent map sort directions to a GraphQl enum",
+		Values: []EnumValue{
+			{
+				Name:        "SortOrderAsc",
+				Value:       string(SortOrderAsc),
+				Description: "SortOrderAsc is for ascending sorts",
+			},
+			{
+				Name:        "SortOrderDesc",
+				Value:       string(SortOrderDesc),
+				Description: "SortOrderDesc is for descending sorts",
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "ContentType",
+		Description: "ContentType defines accepted content types",
+		Values: []EnumValue{
+			{
+				Name:  "ContentTypePng",
+				Value: string(ContentTypePng),
+			},
+			{
+				Name:  "ContentTypeJpg",
+				Value: string(ContentTypeJpg),
+			},
+			{
+				Name:  "ContentTypePdf",
+				Value: string(ContentTypePdf),
+			},
+			{
+				Name:  "ContentTypeDocx",
+				Value: string(ContentTypeDocx),
+			},
+			{
+				Name:  "ContentTypeXlsx",
+				Value: string(ContentTypeXlsx),
+			},
+			{
+				Name:  "ContentTypeCsv",
+				Value: string(ContentTypeCsv),
+			},
+			{
+				Name:  "ContentTypeHeic",
+				Value: string(ContentTypeHeic),
+			},
+			{
+				Name:  "ContentTypeHeif",
+				Value: string(ContentTypeHeif),
+			},
+			{
+				Name:  "ContentTypeWebp",
+				Value: string(ContentTypeWebp),
+			},
+			{
+				Name:  "ContentTypeTiff",
+				Value: string(ContentTypeTiff),
+			},
+			{
+				Name:  "ContentTypeDicom",
+				Value: string(ContentTypeDicom),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "Language",
+		Description: "Language defines allowed languages for uploads",
+		Values: []EnumValue{
+			{
+				Name:  "LanguageEn",
+				Value: string(LanguageEn),
+			},
+			{
+				Name:  "LanguageSw",
+				Value: string(LanguageSw),
+			},
+			{
+				Name:  "LanguageFr",
+				Value: string(LanguageFr),
+			},
+			{
+				Name:  "LanguageSo",
+				Value: string(LanguageSo),
+			},
+			{
+				Name:  "LanguageAm",
+				Value: string(LanguageAm),
+			},
+			{
+				Name:  "LanguageKi",
+				Value: string(LanguageKi),
+			},
+			{
+				Name:  "LanguageLuo",
+				Value: string(LanguageLuo),
+			},
+			{
+				Name:  "LanguageLuy",
+				Value: string(LanguageLuy),
+			},
+			{
+				Name:  "LanguageKln",
+				Value: string(LanguageKln),
+			},
+			{
+				Name:  "LanguageKam",
+				Value: string(LanguageKam),
+			},
+			{
+				Name:  "LanguageRw",
+				Value: string(LanguageRw),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "PractitionerSpecialty",
+		Description: "PractitionerSpecialty is a list of recognised health worker specialties.\n\nSee: https://medicalboard.co.ke/resources_page/gazetted-specialties/",
+		Fallback:    string(PractitionerSpecialtyUnspecified),
+		Values: []EnumValue{
+			{
+				Name:  "PractitionerSpecialtyUnspecified",
+				Value: string(PractitionerSpecialtyUnspecified),
+			},
+			{
+				Name:  "PractitionerSpecialtyAnaesthesia",
+				Value: string(PractitionerSpecialtyAnaesthesia),
+			},
+			{
+				Name:  "PractitionerSpecialtyCardiothoracicSurgery",
+				Value: string(PractitionerSpecialtyCardiothoracicSurgery),
+			},
+			{
+				Name:  "PractitionerSpecialtyClinicalMedicalGenetics",
+				Value: string(PractitionerSpecialtyClinicalMedicalGenetics),
+			},
+			{
+				Name:  "PractitionerSpecialtyClincicalPathology",
+				Value: string(PractitionerSpecialtyClincicalPathology),
+			},
+			{
+				Name:  "PractitionerSpecialtyGeneralPathology",
+				Value: string(PractitionerSpecialtyGeneralPathology),
+			},
+			{
+				Name:  "PractitionerSpecialtyAnatomicPathology",
+				Value: string(PractitionerSpecialtyAnatomicPathology),
+			},
+			{
+				Name:  "PractitionerSpecialtyClinicalOncology",
+				Value: string(PractitionerSpecialtyClinicalOncology),
+			},
+			{
+				Name:  "PractitionerSpecialtyDermatology",
+				Value: string(PractitionerSpecialtyDermatology),
+			},
+			{
+				Name:  "PractitionerSpecialtyEarNoseAndThroat",
+				Value: string(PractitionerSpecialtyEarNoseAndThroat),
+			},
+			{
+				Name:  "PractitionerSpecialtyEmergencyMedicine",
+				Value: string(PractitionerSpecialtyEmergencyMedicine),
+			},
+			{
+				Name:  "PractitionerSpecialtyFamilyMedicine",
+				Value: string(PractitionerSpecialtyFamilyMedicine),
+			},
+			{
+				Name:  "PractitionerSpecialtyGeneralSurgery",
+				Value: string(PractitionerSpecialtyGeneralSurgery),
+			},
+			{
+				Name:  "PractitionerSpecialtyGeriatrics",
+				Value: string(PractitionerSpecialtyGeriatrics),
+			},
+			{
+				Name:  "PractitionerSpecialtyImmunology",
+				Value: string(PractitionerSpecialtyImmunology),
+			},
+			{
+				Name:  "PractitionerSpecialtyInfectiousDisease",
+				Value: string(PractitionerSpecialtyInfectiousDisease),
+			},
+			{
+				Name:  "PractitionerSpecialtyInternalMedicine",
+				Value: string(PractitionerSpecialtyInternalMedicine),
+			},
+			{
+				Name:  "PractitionerSpecialtyMicrobiology",
+				Value: string(PractitionerSpecialtyMicrobiology),
+			},
+			{
+				Name:  "PractitionerSpecialtyNeurosurgery",
+				Value: string(PractitionerSpecialtyNeurosurgery),
+			},
+			{
+				Name:  "PractitionerSpecialtyObstetricsAndGynaecology",
+				Value: string(PractitionerSpecialtyObstetricsAndGynaecology),
+			},
+			{
+				Name:  "PractitionerSpecialtyOccupationalMedicine",
+				Value: string(PractitionerSpecialtyOccupationalMedicine),
+			},
+			{
+				Name:  "PractitionerSpecialtyOphthalmology",
+				Value: string(PractitionerSpecialtyOphthalmology),
+			},
+			{
+				Name:  "PractitionerSpecialtyOrthopaedicSurgery",
+				Value: string(PractitionerSpecialtyOrthopaedicSurgery),
+			},
+			{
+				Name:  "PractitionerSpecialtyOncology",
+				Value: string(PractitionerSpecialtyOncology),
+			},
+			{
+				Name:  "PractitionerSpecialtyOncologyRadiotherapy",
+				Value: string(PractitionerSpecialtyOncologyRadiotherapy),
+			},
+			{
+				Name:  "PractitionerSpecialtyPaediatricsAndChildHealth",
+				Value: string(PractitionerSpecialtyPaediatricsAndChildHealth),
+			},
+			{
+				Name:  "PractitionerSpecialtyPalliativeMedicine",
+				Value: string(PractitionerSpecialtyPalliativeMedicine),
+			},
+			{
+				Name:  "PractitionerSpecialtyPlasticAndReconstructiveSurgery",
+				Value: string(PractitionerSpecialtyPlasticAndReconstructiveSurgery),
+			},
+			{
+				Name:  "PractitionerSpecialtyPsychiatry",
+				Value: string(PractitionerSpecialtyPsychiatry),
+			},
+			{
+				Name:  "PractitionerSpecialtyPublicHealth",
+				Value: string(PractitionerSpecialtyPublicHealth),
+			},
+			{
+				Name:  "PractitionerSpecialtyRadiology",
+				Value: string(PractitionerSpecialtyRadiology),
+			},
+			{
+				Name:  "PractitionerSpecialtyUrology",
+				Value: string(PractitionerSpecialtyUrology),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "CalendarView",
+		Description: "CalendarView is used to determine what view of a calendar to render",
+		Values: []EnumValue{
+			{
+				Name:  "CalendarViewDay",
+				Value: string(CalendarViewDay),
+			},
+			{
+				Name:  "CalendarViewWeek",
+				Value: string(CalendarViewWeek),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "AddressType",
+		Description: "AddressType represents the types of addresses we have",
+		Values: []EnumValue{
+			{
+				Name:  "AddressTypeHome",
+				Value: string(AddressTypeHome),
+			},
+			{
+				Name:  "AddressTypeWork",
+				Value: string(AddressTypeWork),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "IdentificationDocType",
+		Description: "IdentificationDocType defines the various supplier IdentificationDocTypes",
+		Values: []EnumValue{
+			{
+				Name:  "IdentificationDocTypeNationalid",
+				Value: string(IdentificationDocTypeNationalid),
+			},
+			{
+				Name:  "IdentificationDocTypePassport",
+				Value: string(IdentificationDocTypePassport),
+			},
+			{
+				Name:  "IdentificationDocTypeMilitary",
+				Value: string(IdentificationDocTypeMilitary),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "SenderID",
+		Description: "SenderID defines the various AT Sender IDs that we have and can use",
+		Values: []EnumValue{
+			{
+				Name:  "SenderIDSLADE360",
+				Value: string(SenderIDSLADE360),
+			},
+			{
+				Name:  "SenderIDBewell",
+				Value: string(SenderIDBewell),
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "FilterLogic",
+		Description: "FilterLogic is used to combine the filters and groups of a FilterSet",
+		Values: []EnumValue{
+			{
+				Name:        "FilterLogicAnd",
+				Value:       string(FilterLogicAnd),
+				Description: "FilterLogicAnd matches when all the filters match",
+			},
+			{
+				Name:        "FilterLogicOr",
+				Value:       string(FilterLogicOr),
+				Description: "FilterLogicOr matches when any of the filters match",
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "NullsOrder",
+		Description: "NullsOrder is used to place missing values before or after the other values\nof a sort",
+		Values: []EnumValue{
+			{
+				Name:        "NullsOrderFirst",
+				Value:       string(NullsOrderFirst),
+				Description: "NullsOrderFirst sorts missing values before the other values",
+			},
+			{
+				Name:        "NullsOrderLast",
+				Value:       string(NullsOrderLast),
+				Description: "NullsOrderLast sorts missing values after the other values",
+			},
+		},
+	})
+	Register(EnumInfo{
+		Name:        "UploadPurpose",
+		Description: "UploadPurpose is what an uploaded file will be used for",
+		Values: []EnumValue{
+			{
+				Name:        "UploadPurposeAvatar",
+				Value:       string(UploadPurposeAvatar),
+				Description: "UploadPurposeAvatar is a profile picture",
+			},
+			{
+				Name:        "UploadPurposeClinicalDocument",
+				Value:       string(UploadPurposeClinicalDocument),
+				Description: "UploadPurposeClinicalDocument is a document added to a patient's record\ne.g a referral letter or a scan",
+			},
+			{
+				Name:        "UploadPurposeLabReport",
+				Value:       string(UploadPurposeLabReport),
+				Description: "UploadPurposeLabReport is the result of a laboratory test",
+			},
+			{
+				Name:        "UploadPurposeIdentificationDocument",
+				Value:       string(UploadPurposeIdentificationDocument),
+				Description: "UploadPurposeIdentificationDocument is a copy of an identification\ndocument e.g a national ID or passport",
+			},
+		},
+	})
+}
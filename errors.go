@@ -0,0 +1,158 @@
+package enumutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrNotString is returned when an enum is unmarshalled from a value that
+	// is not a string
+	ErrNotString = errors.New("enums must be strings")
+
+	// ErrInvalidValue is returned when a string is not one of the values of
+	// an enum
+	ErrInvalidValue = errors.New("invalid enum value")
+
+	// ErrNull is returned when NULL is read from the database into an enum
+	ErrNull = errors.New("enums cannot be NULL")
+)
+
+// InvalidEnumError is returned by every enum when the input cannot be
+// unmarshalled. It wraps either ErrNotString or ErrInvalidValue so that
+// callers can use errors.Is, and carries enough detail for clients to correct
+// their input.
+type InvalidEnumError struct {
+	// EnumName is the name of the enum type e.g Gender
+	EnumName string
+
+	// Value is the rejected input. Inputs that are not strings are formatted
+	// with fmt.Sprint.
+	Value string
+
+	// Allowed are the valid values of the enum
+	Allowed []string
+
+	// Suggestion is the allowed value closest to the input. It is empty when
+	// no value is close enough to be a likely match.
+	Suggestion string
+
+	// Err is ErrNotString or ErrInvalidValue. A nil Err is treated as
+	// ErrInvalidValue.
+	Err error
+}
+
+func newInvalidEnumError(enumName, value string, allowed []string) *InvalidEnumError {
+	return &InvalidEnumError{
+		EnumName:   enumName,
+		Value:      value,
+		Allowed:    allowed,
+		Suggestion: suggest(value, allowed),
+		Err:        ErrInvalidValue,
+	}
+}
+
+func newNotStringError(enumName string, value interface{}, allowed []string) *InvalidEnumError {
+	return &InvalidEnumError{
+		EnumName: enumName,
+		Value:    fmt.Sprint(value),
+		Allowed:  allowed,
+		Err:      ErrNotString,
+	}
+}
+
+func (e *InvalidEnumError) Error() string {
+	if errors.Is(e.Err, ErrNotString) {
+		return ErrNotString.Error()
+	}
+	if e.Suggestion != "" {
+		return fmt.Sprintf("%s is not a valid %s, did you mean %s?", e.Value, e.EnumName, e.Suggestion)
+	}
+	return fmt.Sprintf("%s is not a valid %s", e.Value, e.EnumName)
+}
+
+// Unwrap returns the sentinel error describing why the input was rejected
+func (e *InvalidEnumError) Unwrap() error {
+	if e.Err == nil {
+		return ErrInvalidValue
+	}
+	return e.Err
+}
+
+// Extensions returns the details of the error in a form that can be added to
+// the extensions of a GraphQL error
+func (e *InvalidEnumError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"enum":    e.EnumName,
+		"value":   e.Value,
+		"allowed": e.Allowed,
+	}
+	if e.Suggestion != "" {
+		extensions["suggestion"] = e.Suggestion
+	}
+	return extensions
+}
+
+// suggest returns the allowed value that most likely was meant by the input.
+// A value that differs only in case or separators is always suggested,
+// otherwise the value with the smallest edit distance is suggested if no more
+// than a third of it has to change.
+func suggest(value string, allowed []string) string {
+	input := foldEnum(value)
+	if input == "" {
+		return ""
+	}
+
+	best, bestDistance := "", -1
+	for _, candidate := range allowed {
+		folded := foldEnum(candidate)
+		if folded == input {
+			return candidate
+		}
+
+		distance := levenshtein(input, folded)
+		if bestDistance == -1 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	if bestDistance == -1 || bestDistance > max(1, utf8.RuneCountInString(best)/3) {
+		return ""
+	}
+	return best
+}
+
+// foldEnum normalises case, surrounding whitespace and the separators
+// commonly used in enum values so that e.g "Prefer not to say" and
+// "prefer_not_to_say" compare equal
+func foldEnum(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(s)
+}
+
+// levenshtein returns the number of single rune insertions, deletions and
+// substitutions needed to turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,158 @@
+package enumutils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidEnumError(t *testing.T) {
+	tests := []struct {
+		name           string
+		unmarshal      func(v interface{}) error
+		input          string
+		wantEnum       string
+		wantSuggestion string
+		wantMessage    string
+	}{
+		{
+			name:           "Sad case: gender in the wrong case",
+			unmarshal:      new(enumutils.Gender).UnmarshalGQL,
+			input:          "Male",
+			wantEnum:       "Gender",
+			wantSuggestion: "male",
+			wantMessage:    "Male is not a valid Gender, did you mean male?",
+		},
+		{
+			name:           "Sad case: misspelt practitioner specialty",
+			unmarshal:      new(enumutils.PractitionerSpecialty).UnmarshalGQL,
+			input:          "CLINICAL_PATHOLOGY",
+			wantEnum:       "PractitionerSpecialty",
+			wantSuggestion: "CLINCICAL_PATHOLOGY",
+			wantMessage:    "CLINICAL_PATHOLOGY is not a valid PractitionerSpecialty, did you mean CLINCICAL_PATHOLOGY?",
+		},
+		{
+			name:           "Sad case: spaces instead of underscores",
+			unmarshal:      new(enumutils.Gender).UnmarshalGQL,
+			input:          "Prefer not to say",
+			wantEnum:       "Gender",
+			wantSuggestion: "prefer_not_to_say",
+			wantMessage:    "Prefer not to say is not a valid Gender, did you mean prefer_not_to_say?",
+		},
+		{
+			name:           "Sad case: three letter language code",
+			unmarshal:      new(enumutils.Language).UnmarshalGQL,
+			input:          "eng",
+			wantEnum:       "Language",
+			wantSuggestion: "en",
+			wantMessage:    "eng is not a valid Language, did you mean en?",
+		},
+		{
+			name:        "Sad case: nothing close enough",
+			unmarshal:   new(enumutils.SortOrder).UnmarshalGQL,
+			input:       "RANDOM",
+			wantEnum:    "SortOrder",
+			wantMessage: "RANDOM is not a valid SortOrder",
+		},
+		{
+			name:        "Sad case: empty input",
+			unmarshal:   new(enumutils.SenderID).UnmarshalGQL,
+			input:       "",
+			wantEnum:    "SenderID",
+			wantMessage: " is not a valid SenderID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.unmarshal(tt.input)
+
+			var invalid *enumutils.InvalidEnumError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("expected an InvalidEnumError, got %v", err)
+			}
+			assert.Equal(t, tt.wantEnum, invalid.EnumName)
+			assert.Equal(t, tt.input, invalid.Value)
+			assert.Equal(t, tt.wantSuggestion, invalid.Suggestion)
+			assert.Equal(t, tt.wantMessage, invalid.Error())
+
+			info, _ := enumutils.Lookup(tt.wantEnum)
+			assert.Equal(t, info.Strings(), invalid.Allowed)
+		})
+	}
+}
+
+func TestInvalidEnumError_Extensions(t *testing.T) {
+	err := new(enumutils.CalendarView).UnmarshalGQL("day")
+
+	var invalid *enumutils.InvalidEnumError
+	assert.True(t, errors.As(err, &invalid))
+	assert.Equal(t, map[string]interface{}{
+		"enum":       "CalendarView",
+		"value":      "day",
+		"allowed":    []string{"DAY", "WEEK"},
+		"suggestion": "DAY",
+	}, invalid.Extensions())
+
+	err = new(enumutils.CalendarView).UnmarshalGQL("MONTH")
+	assert.True(t, errors.As(err, &invalid))
+	assert.NotContains(t, invalid.Extensions(), "suggestion")
+}
+
+func TestInvalidEnumError_Is(t *testing.T) {
+	unmarshallers := map[string]func(v interface{}) error{
+		"Gender":                new(enumutils.Gender).UnmarshalGQL,
+		"FieldType":             new(enumutils.FieldType).UnmarshalGQL,
+		"Operation":             new(enumutils.Operation).UnmarshalGQL,
+		"SortOrder":             new(enumutils.SortOrder).UnmarshalGQL,
+		"ContentType":           new(enumutils.ContentType).UnmarshalGQL,
+		"Language":              new(enumutils.Language).UnmarshalGQL,
+		"PractitionerSpecialty": new(enumutils.PractitionerSpecialty).UnmarshalGQL,
+		"CalendarView":          new(enumutils.CalendarView).UnmarshalGQL,
+		"AddressType":           new(enumutils.AddressType).UnmarshalGQL,
+		"IdentificationDocType": new(enumutils.IdentificationDocType).UnmarshalGQL,
+		"SenderID":              new(enumutils.SenderID).UnmarshalGQL,
+	}
+	for name, unmarshal := range unmarshallers {
+		t.Run(name, func(t *testing.T) {
+			var invalid *enumutils.InvalidEnumError
+
+			err := unmarshal(42)
+			assert.True(t, errors.Is(err, enumutils.ErrNotString))
+			assert.False(t, errors.Is(err, enumutils.ErrInvalidValue))
+			assert.Equal(t, "enums must be strings", err.Error())
+			if assert.True(t, errors.As(err, &invalid)) {
+				assert.Equal(t, name, invalid.EnumName)
+				assert.Equal(t, "42", invalid.Value)
+			}
+
+			err = unmarshal("not a valid value")
+			assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+			assert.False(t, errors.Is(err, enumutils.ErrNotString))
+			if assert.True(t, errors.As(err, &invalid)) {
+				assert.Equal(t, name, invalid.EnumName)
+				assert.Equal(t, "not a valid value", invalid.Value)
+			}
+		})
+	}
+}
+
+func TestInvalidEnumError_OtherDecoders(t *testing.T) {
+	var gender enumutils.Gender
+
+	assert.True(t, errors.Is(gender.UnmarshalJSON([]byte(`"MALE"`)), enumutils.ErrInvalidValue))
+	assert.True(t, errors.Is(gender.UnmarshalJSON([]byte(`1`)), enumutils.ErrNotString))
+	assert.True(t, errors.Is(gender.Scan("MALE"), enumutils.ErrInvalidValue))
+	assert.True(t, errors.Is(gender.Scan(1), enumutils.ErrNotString))
+	assert.True(t, errors.Is(gender.Scan(nil), enumutils.ErrNull))
+
+	_, err := enumutils.Gender("MALE").Value()
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+
+	_, err = enumutils.Parse[enumutils.Gender]("MALE")
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+
+	// errors constructed without a sentinel are invalid values
+	assert.True(t, errors.Is(&enumutils.InvalidEnumError{EnumName: "Colour", Value: "PINK"}, enumutils.ErrInvalidValue))
+}
@@ -0,0 +1,10 @@
+package enumutils
+
+// Unregister removes an enum from the registry so that tests which register
+// their own enums leave it as they found it
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(registry, name)
+}
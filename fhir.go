@@ -0,0 +1,77 @@
+package enumutils
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedCodingSystem is returned when a FHIR coding is not from the
+// coding system of the enum it is converted to
+var ErrUnsupportedCodingSystem = errors.New("unsupported coding system")
+
+// FHIRCoding is a FHIR Coding, a code defined by a terminology system
+//
+// See: https://www.hl7.org/fhir/datatypes.html#Coding
+type FHIRCoding struct {
+	System  string `json:"system,omitempty"`
+	Version string `json:"version,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// FHIRCodeableConcept is a FHIR CodeableConcept, a concept that may be
+// defined by codings from several terminology systems
+//
+// See: https://www.hl7.org/fhir/datatypes.html#CodeableConcept
+type FHIRCodeableConcept struct {
+	Coding []FHIRCoding `json:"coding,omitempty"`
+	Text   string       `json:"text,omitempty"`
+}
+
+// Coding returns the language as a FHIR coding in the BCP 47 coding system
+// e.g {"system": "urn:ietf:bcp:47", "code": "sw", "display": "Swahili"}
+func (e Language) Coding() FHIRCoding {
+	return FHIRCoding{
+		System:  LanguageCodingSystem,
+		Version: LanguageCodingVersion,
+		Code:    e.String(),
+		Display: LanguageNames[e],
+	}
+}
+
+// CodeableConcept returns the language as a FHIR codeable concept, such as
+// the language of a Patient.communication
+func (e Language) CodeableConcept() FHIRCodeableConcept {
+	coding := e.Coding()
+	return FHIRCodeableConcept{
+		Coding: []FHIRCoding{coding},
+		Text:   coding.Display,
+	}
+}
+
+// LanguageFromCoding returns the language of a FHIR coding. The coding must
+// be from the BCP 47 coding system; its code is parsed with ParseLanguageTag,
+// so regional codes such as en-KE are accepted, and its display is ignored.
+func LanguageFromCoding(coding FHIRCoding) (Language, error) {
+	if coding.System != LanguageCodingSystem {
+		return "", fmt.Errorf("%w %q, languages must be coded with %s", ErrUnsupportedCodingSystem, coding.System, LanguageCodingSystem)
+	}
+	tag, err := ParseLanguageTag(coding.Code)
+	if err != nil {
+		return "", err
+	}
+	return tag.Language, nil
+}
+
+// LanguageFromCodeableConcept returns the language of the first coding of a
+// FHIR codeable concept that is from the BCP 47 coding system. Codings from
+// other systems are skipped, but a concept without any BCP 47 coding is an
+// error.
+func LanguageFromCodeableConcept(concept FHIRCodeableConcept) (Language, error) {
+	for _, coding := range concept.Coding {
+		if coding.System == LanguageCodingSystem {
+			return LanguageFromCoding(coding)
+		}
+	}
+	return "", fmt.Errorf("%w: %q has no %s coding", ErrUnsupportedCodingSystem, concept.Text, LanguageCodingSystem)
+}
@@ -0,0 +1,130 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguage_Coding(t *testing.T) {
+	coding := enumutils.LanguageSw.Coding()
+	assert.Equal(t, enumutils.FHIRCoding{
+		System:  enumutils.LanguageCodingSystem,
+		Version: enumutils.LanguageCodingVersion,
+		Code:    "sw",
+		Display: "Swahili",
+	}, coding)
+
+	data, err := json.Marshal(enumutils.LanguageEn.CodeableConcept())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"coding": [{"system": "urn:ietf:bcp:47", "code": "en", "display": "English"}], "text": "English"}`, string(data))
+
+	for _, language := range enumutils.AllLanguage {
+		got, err := enumutils.LanguageFromCodeableConcept(language.CodeableConcept())
+		assert.NoError(t, err)
+		assert.Equal(t, language, got)
+	}
+}
+
+func TestLanguageFromCoding(t *testing.T) {
+	tests := []struct {
+		name    string
+		coding  enumutils.FHIRCoding
+		want    enumutils.Language
+		wantErr error
+	}{
+		{
+			name:   "code",
+			coding: enumutils.FHIRCoding{System: enumutils.LanguageCodingSystem, Code: "en"},
+			want:   enumutils.LanguageEn,
+		},
+		{
+			name:   "alias",
+			coding: enumutils.FHIRCoding{System: enumutils.LanguageCodingSystem, Code: "swa", Display: "Kiswahili"},
+			want:   enumutils.LanguageSw,
+		},
+		{
+			name:   "regional code",
+			coding: enumutils.FHIRCoding{System: enumutils.LanguageCodingSystem, Code: "en-KE"},
+			want:   enumutils.LanguageEn,
+		},
+		{
+			name:    "malformed code",
+			coding:  enumutils.FHIRCoding{System: enumutils.LanguageCodingSystem, Code: "en--KE"},
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "other system",
+			coding:  enumutils.FHIRCoding{System: "urn:iso:std:iso:639", Code: "en"},
+			wantErr: enumutils.ErrUnsupportedCodingSystem,
+		},
+		{
+			name:    "missing system",
+			coding:  enumutils.FHIRCoding{Code: "en"},
+			wantErr: enumutils.ErrUnsupportedCodingSystem,
+		},
+		{
+			name:    "unknown code",
+			coding:  enumutils.FHIRCoding{System: enumutils.LanguageCodingSystem, Code: "xx"},
+			wantErr: enumutils.ErrInvalidValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.LanguageFromCoding(tt.coding)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLanguageFromCodeableConcept(t *testing.T) {
+	var concept enumutils.FHIRCodeableConcept
+	err := json.Unmarshal([]byte(`{
+		"coding": [
+			{"system": "http://snomed.info/sct", "code": "297507001"},
+			{"system": "urn:ietf:bcp:47", "code": "sw"}
+		],
+		"text": "Swahili"
+	}`), &concept)
+	assert.NoError(t, err)
+
+	got, err := enumutils.LanguageFromCodeableConcept(concept)
+	assert.NoError(t, err)
+	assert.Equal(t, enumutils.LanguageSw, got)
+
+	_, err = enumutils.LanguageFromCodeableConcept(enumutils.FHIRCodeableConcept{
+		Coding: []enumutils.FHIRCoding{{System: "http://snomed.info/sct", Code: "297507001"}},
+		Text:   "Swahili",
+	})
+	if assert.Error(t, err) {
+		assert.True(t, errors.Is(err, enumutils.ErrUnsupportedCodingSystem))
+		assert.Equal(t, `unsupported coding system: "Swahili" has no urn:ietf:bcp:47 coding`, err.Error())
+	}
+
+	_, err = enumutils.LanguageFromCodeableConcept(enumutils.FHIRCodeableConcept{
+		Coding: []enumutils.FHIRCoding{{System: enumutils.LanguageCodingSystem, Code: "zz"}},
+	})
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+}
+
+func TestLanguageMetadata(t *testing.T) {
+	for _, language := range enumutils.AllLanguage {
+		t.Run(language.String(), func(t *testing.T) {
+			assert.NotEmpty(t, enumutils.LanguageNames[language])
+			assert.NotEmpty(t, enumutils.LanguageNativeNames[language])
+			if assert.Len(t, enumutils.LanguageAlpha3Codes[language], 3) {
+				tag, err := enumutils.ParseLanguageTag(enumutils.LanguageAlpha3Codes[language] + "-KE")
+				assert.NoError(t, err)
+				assert.Equal(t, language, tag.Language)
+			}
+		})
+	}
+}
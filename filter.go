@@ -0,0 +1,427 @@
+package enumutils
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnsupportedOperation is returned when an operation cannot be applied
+	// to a field type e.g CONTAINS on a BOOLEAN field
+	ErrUnsupportedOperation = errors.New("unsupported filter operation")
+
+	// ErrInvalidFilterValue is returned when a value cannot be converted to
+	// the field type of a filter
+	ErrInvalidFilterValue = errors.New("invalid filter value")
+)
+
+// FilterError is returned when a filter cannot be evaluated
+type FilterError struct {
+	FieldType FieldType
+	Operation Operation
+
+	// Value is the value that could not be used, if any
+	Value interface{}
+
+	// Reason explains why the value could not be used
+	Reason string
+
+	// Err is ErrUnsupportedOperation or ErrInvalidFilterValue
+	Err error
+}
+
+func (e *FilterError) Error() string {
+	if errors.Is(e.Err, ErrUnsupportedOperation) {
+		supported := []string{}
+		for _, operation := range e.FieldType.Operations() {
+			supported = append(supported, operation.String())
+		}
+		return fmt.Sprintf(
+			"%s is not supported for %s filters, use one of %s",
+			e.Operation, e.FieldType, strings.Join(supported, ", "),
+		)
+	}
+	return fmt.Sprintf("invalid %s filter value: %s", e.FieldType, e.Reason)
+}
+
+// Unwrap returns ErrUnsupportedOperation or ErrInvalidFilterValue
+func (e *FilterError) Unwrap() error {
+	return e.Err
+}
+
+func newFilterValueError(fieldType FieldType, operation Operation, value interface{}, reason error) *FilterError {
+	return &FilterError{
+		FieldType: fieldType,
+		Operation: operation,
+		Value:     value,
+		Reason:    reason.Error(),
+		Err:       ErrInvalidFilterValue,
+	}
+}
+
+// fieldTypeOperations lists the operations that can be applied to each
+// field type, in the order of AllOperation. Booleans can't be ordered and
+// strings aren't ordered because their collation differs between services and
+// databases.
+var fieldTypeOperations = map[FieldType][]Operation{
+	FieldTypeBoolean: {
+		OperationEqual,
+		OperationIn,
+	},
+	FieldTypeTimestamp: {
+		OperationLessThan,
+		OperationLessThanOrEqualTo,
+		OperationEqual,
+		OperationGreaterThan,
+		OperationGreaterThanOrEqualTo,
+		OperationIn,
+	},
+	FieldTypeNumber: {
+		OperationLessThan,
+		OperationLessThanOrEqualTo,
+		OperationEqual,
+		OperationGreaterThan,
+		OperationGreaterThanOrEqualTo,
+		OperationIn,
+	},
+	FieldTypeInteger: {
+		OperationLessThan,
+		OperationLessThanOrEqualTo,
+		OperationEqual,
+		OperationGreaterThan,
+		OperationGreaterThanOrEqualTo,
+		OperationIn,
+	},
+	FieldTypeString: {
+		OperationEqual,
+		OperationIn,
+		OperationContains,
+	},
+}
+
+// Operations returns the operations that can be applied to the field type
+func (e FieldType) Operations() []Operation {
+	return append([]Operation(nil), fieldTypeOperations[e]...)
+}
+
+// SupportsOperation returns true if the operation can be applied to values of
+// the field type e.g CONTAINS is only supported for STRING fields
+func (e FieldType) SupportsOperation(operation Operation) bool {
+	for _, supported := range fieldTypeOperations[e] {
+		if supported == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateFilter checks that the field type and operation are valid and that
+// the operation can be applied to the field type, so that nonsensical filters
+// are rejected before they are evaluated or sent to a database
+func ValidateFilter(fieldType FieldType, operation Operation) error {
+	if !fieldType.IsValid() {
+		return newInvalidEnumError(fieldType.EnumName(), fieldType.String(), fieldType.Values())
+	}
+	if !operation.IsValid() {
+		return newInvalidEnumError(operation.EnumName(), operation.String(), operation.Values())
+	}
+	if !fieldType.SupportsOperation(operation) {
+		return &FilterError{FieldType: fieldType, Operation: operation, Err: ErrUnsupportedOperation}
+	}
+	return nil
+}
+
+// timestampLayouts are the string formats accepted for TIMESTAMP filters
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+// Evaluate reports whether the candidate value matches the filter described
+// by the field type, operation and filter value. Both values are converted to
+// the field type first:
+//
+//   - BOOLEAN accepts bools and strings such as "true"
+//   - TIMESTAMP accepts time.Time and RFC 3339 or YYYY-MM-DD strings
+//   - NUMBER accepts any Go number, json.Number and numeric strings
+//   - INTEGER is like NUMBER but rejects values with a fractional part
+//   - STRING accepts strings and values with a String method, such as enums
+//
+// Pointers to any of these, such as the *string or *time.Time of an optional
+// struct field, are dereferenced. The filter value of IN must be a slice; the
+// candidate matches if it equals any element. CONTAINS matches strings
+// case-insensitively. A nil candidate, e.g a missing field or a nil pointer,
+// never matches.
+func Evaluate(fieldType FieldType, operation Operation, filterValue, candidate interface{}) (bool, error) {
+	if err := ValidateFilter(fieldType, operation); err != nil {
+		return false, err
+	}
+
+	filters, err := coerceFilterValue(fieldType, operation, filterValue)
+	if err != nil {
+		return false, err
+	}
+
+	candidate = deref(candidate)
+	if candidate == nil {
+		return false, nil
+	}
+	value, err := coerce(fieldType, candidate)
+	if err != nil {
+		return false, newFilterValueError(fieldType, operation, candidate, err)
+	}
+
+	for _, filter := range filters {
+		if match(operation, value, filter) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// coerceFilterValue converts the filter value to the field type. It returns
+// a single value for every operation except IN, whose elements are each
+// converted.
+func coerceFilterValue(fieldType FieldType, operation Operation, filterValue interface{}) ([]interface{}, error) {
+	if operation != OperationIn {
+		value, err := coerce(fieldType, filterValue)
+		if err != nil {
+			return nil, newFilterValueError(fieldType, operation, filterValue, err)
+		}
+		return []interface{}{value}, nil
+	}
+
+	if v := deref(filterValue); v != nil {
+		filterValue = v
+	}
+	list := reflect.ValueOf(filterValue)
+	if filterValue == nil || (list.Kind() != reflect.Slice && list.Kind() != reflect.Array) {
+		return nil, newFilterValueError(fieldType, operation, filterValue, fmt.Errorf("IN requires a list of values, got %T", filterValue))
+	}
+
+	values := make([]interface{}, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		value, err := coerce(fieldType, list.Index(i).Interface())
+		if err != nil {
+			return nil, newFilterValueError(fieldType, operation, filterValue, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// coerce converts a value to the Go type used to compare values of the field
+// type: bool, time.Time, float64, int64 or string
+func coerce(fieldType FieldType, value interface{}) (interface{}, error) {
+	// a nil pointer is kept so that the error names its type
+	if v := deref(value); v != nil {
+		value = v
+	}
+	switch fieldType {
+	case FieldTypeBoolean:
+		return coerceBool(value)
+	case FieldTypeTimestamp:
+		return coerceTime(value)
+	case FieldTypeNumber:
+		return coerceFloat(value)
+	case FieldTypeInteger:
+		return coerceInt(value)
+	default:
+		return coerceString(value)
+	}
+}
+
+// deref returns the value that a pointer, or a chain of pointers, points to
+// and nil for a nil pointer. Other values are returned unchanged.
+func deref(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer {
+		return value
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+func coerceBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("%q is not a boolean", v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("%T is not a boolean", value)
+	}
+}
+
+func coerceTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp", v)
+	}
+	return time.Time{}, fmt.Errorf("%T is not a timestamp", value)
+}
+
+func coerceFloat(value interface{}) (float64, error) {
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case int8:
+		f = float64(v)
+	case int16:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case uint:
+		f = float64(v)
+	case uint8:
+		f = float64(v)
+	case uint16:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case json.Number:
+		return coerceFloat(v.String())
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", v)
+		}
+		f = parsed
+	default:
+		return 0, fmt.Errorf("%T is not a number", value)
+	}
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%v is not a finite number", f)
+	}
+	return f, nil
+}
+
+func coerceInt(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return coerceInt(uint64(v))
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		return int64(v), nil
+	case json.Number:
+		return coerceInt(v.String())
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err == nil {
+			return i, nil
+		}
+	}
+
+	// floats, including those decoded from JSON, are accepted if they hold a
+	// whole number
+	f, err := coerceFloat(value)
+	if err != nil {
+		return 0, fmt.Errorf("%#v is not an integer", value)
+	}
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("%v is not an integer", f)
+	}
+	return int64(f), nil
+}
+
+func coerceString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("%T is not a string", value)
+	}
+}
+
+// match applies the operation to two values that have been coerced to the
+// same type
+func match(operation Operation, value, filter interface{}) bool {
+	if operation == OperationContains {
+		return strings.Contains(strings.ToLower(value.(string)), strings.ToLower(filter.(string)))
+	}
+
+	c := compare(value, filter)
+	switch operation {
+	case OperationLessThan:
+		return c < 0
+	case OperationLessThanOrEqualTo:
+		return c <= 0
+	case OperationGreaterThan:
+		return c > 0
+	case OperationGreaterThanOrEqualTo:
+		return c >= 0
+	default: // OperationEqual and each element of OperationIn
+		return c == 0
+	}
+}
+
+// compare returns -1, 0 or 1 depending on whether a is less than, equal to or
+// greater than b. Booleans are only ever compared for equality.
+func compare(a, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case int64:
+		return cmp.Compare(a, b.(int64))
+	case string:
+		return strings.Compare(a, b.(string))
+	case bool:
+		if a == b.(bool) {
+			return 0
+		}
+		return 1
+	}
+	return 1
+}
@@ -0,0 +1,442 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		fieldType   enumutils.FieldType
+		operation   enumutils.Operation
+		filterValue interface{}
+		candidate   interface{}
+		want        bool
+	}{
+		{
+			name:        "boolean equal",
+			fieldType:   enumutils.FieldTypeBoolean,
+			operation:   enumutils.OperationEqual,
+			filterValue: "true",
+			candidate:   true,
+			want:        true,
+		},
+		{
+			name:        "boolean not equal",
+			fieldType:   enumutils.FieldTypeBoolean,
+			operation:   enumutils.OperationEqual,
+			filterValue: false,
+			candidate:   "TRUE",
+			want:        false,
+		},
+		{
+			name:        "timestamp less than",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationLessThan,
+			filterValue: "2024-03-01T10:00:00Z",
+			candidate:   now.Add(-time.Second),
+			want:        true,
+		},
+		{
+			name:        "timestamp equal across time zones",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationEqual,
+			filterValue: "2024-03-01T13:00:00+03:00",
+			candidate:   &now,
+			want:        true,
+		},
+		{
+			name:        "timestamp greater than or equal to a date",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationGreaterThanOrEqualTo,
+			filterValue: "2024-03-02",
+			candidate:   now,
+			want:        false,
+		},
+		{
+			name:        "number greater than",
+			fieldType:   enumutils.FieldTypeNumber,
+			operation:   enumutils.OperationGreaterThan,
+			filterValue: 36.5,
+			candidate:   json.Number("37.2"),
+			want:        true,
+		},
+		{
+			name:        "number less than or equal to",
+			fieldType:   enumutils.FieldTypeNumber,
+			operation:   enumutils.OperationLessThanOrEqualTo,
+			filterValue: "10",
+			candidate:   uint8(10),
+			want:        true,
+		},
+		{
+			name:        "integer from a JSON float",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationGreaterThanOrEqualTo,
+			filterValue: float64(18),
+			candidate:   int32(17),
+			want:        false,
+		},
+		{
+			name:        "integer in",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationIn,
+			filterValue: []interface{}{1.0, "2", json.Number("3")},
+			candidate:   int64(3),
+			want:        true,
+		},
+		{
+			name:        "string in",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationIn,
+			filterValue: []string{"male", "female"},
+			candidate:   enumutils.GenderFemale,
+			want:        true,
+		},
+		{
+			name:        "string not in",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationIn,
+			filterValue: [2]string{"male", "female"},
+			candidate:   "other",
+			want:        false,
+		},
+		{
+			name:        "string contains ignoring case",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationContains,
+			filterValue: "KEN",
+			candidate:   "Nairobi, Kenya",
+			want:        true,
+		},
+		{
+			name:        "string equal is case sensitive",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationEqual,
+			filterValue: "Kenya",
+			candidate:   "kenya",
+			want:        false,
+		},
+		{
+			name:        "nil candidate never matches",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationEqual,
+			filterValue: "",
+			candidate:   nil,
+			want:        false,
+		},
+		{
+			name:        "nil timestamp pointer never matches",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationEqual,
+			filterValue: "2020-01-01",
+			candidate:   (*time.Time)(nil),
+			want:        false,
+		},
+		{
+			name:        "nil string pointer never matches",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationIn,
+			filterValue: []string{""},
+			candidate:   (*string)(nil),
+			want:        false,
+		},
+		{
+			name:        "timestamp pointer",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationGreaterThanOrEqualTo,
+			filterValue: &now,
+			candidate:   &now,
+			want:        true,
+		},
+		{
+			name:        "string pointer",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationContains,
+			filterValue: "nai",
+			candidate:   pointer("Nairobi"),
+			want:        true,
+		},
+		{
+			name:        "integer pointer",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationIn,
+			filterValue: []*int64{pointer(int64(1)), pointer(int64(2))},
+			candidate:   pointer(int64(2)),
+			want:        true,
+		},
+		{
+			name:        "number pointer to pointer",
+			fieldType:   enumutils.FieldTypeNumber,
+			operation:   enumutils.OperationLessThan,
+			filterValue: 3,
+			candidate:   pointer(pointer(2.5)),
+			want:        true,
+		},
+		{
+			name:        "boolean pointer",
+			fieldType:   enumutils.FieldTypeBoolean,
+			operation:   enumutils.OperationEqual,
+			filterValue: pointer(true),
+			candidate:   pointer(true),
+			want:        true,
+		},
+		{
+			name:        "enum pointer",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationEqual,
+			filterValue: "female",
+			candidate:   pointer(enumutils.GenderFemale),
+			want:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.Evaluate(tt.fieldType, tt.operation, tt.filterValue, tt.candidate)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		fieldType   enumutils.FieldType
+		operation   enumutils.Operation
+		filterValue interface{}
+		candidate   interface{}
+		wantErr     error
+		wantMessage string
+	}{
+		{
+			name:        "invalid field type",
+			fieldType:   "DATE",
+			operation:   enumutils.OperationEqual,
+			filterValue: "2024-01-01",
+			candidate:   "2024-01-01",
+			wantErr:     enumutils.ErrInvalidValue,
+			wantMessage: "DATE is not a valid FieldType",
+		},
+		{
+			name:        "invalid operation",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   "equal",
+			filterValue: "a",
+			candidate:   "a",
+			wantErr:     enumutils.ErrInvalidValue,
+			wantMessage: "equal is not a valid Operation, did you mean EQUAL?",
+		},
+		{
+			name:        "contains on a boolean",
+			fieldType:   enumutils.FieldTypeBoolean,
+			operation:   enumutils.OperationContains,
+			filterValue: true,
+			candidate:   true,
+			wantErr:     enumutils.ErrUnsupportedOperation,
+			wantMessage: "CONTAINS is not supported for BOOLEAN filters, use one of EQUAL, IN",
+		},
+		{
+			name:        "invalid number",
+			fieldType:   enumutils.FieldTypeNumber,
+			operation:   enumutils.OperationEqual,
+			filterValue: "ten",
+			candidate:   10,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: `invalid NUMBER filter value: "ten" is not a number`,
+		},
+		{
+			name:        "fractional integer",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationEqual,
+			filterValue: 1.5,
+			candidate:   1,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid INTEGER filter value: 1.5 is not an integer",
+		},
+		{
+			name:        "overflowing integer",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationEqual,
+			filterValue: uint64(math.MaxUint64),
+			candidate:   1,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid INTEGER filter value: 18446744073709551615 overflows int64",
+		},
+		{
+			name:        "infinite number",
+			fieldType:   enumutils.FieldTypeNumber,
+			operation:   enumutils.OperationEqual,
+			filterValue: math.Inf(1),
+			candidate:   1,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid NUMBER filter value: +Inf is not a finite number",
+		},
+		{
+			name:        "invalid timestamp",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationEqual,
+			filterValue: "yesterday",
+			candidate:   time.Now(),
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: `invalid TIMESTAMP filter value: "yesterday" is not an RFC 3339 timestamp`,
+		},
+		{
+			name:        "invalid boolean",
+			fieldType:   enumutils.FieldTypeBoolean,
+			operation:   enumutils.OperationEqual,
+			filterValue: "yes please",
+			candidate:   true,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: `invalid BOOLEAN filter value: "yes please" is not a boolean`,
+		},
+		{
+			name:        "in without a list",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationIn,
+			filterValue: "male",
+			candidate:   "male",
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid STRING filter value: IN requires a list of values, got string",
+		},
+		{
+			name:        "invalid element in a list",
+			fieldType:   enumutils.FieldTypeInteger,
+			operation:   enumutils.OperationIn,
+			filterValue: []interface{}{1, "two"},
+			candidate:   1,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: `invalid INTEGER filter value: "two" is not an integer`,
+		},
+		{
+			name:        "candidate of the wrong type",
+			fieldType:   enumutils.FieldTypeString,
+			operation:   enumutils.OperationEqual,
+			filterValue: "1",
+			candidate:   1,
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid STRING filter value: int is not a string",
+		},
+		{
+			name:        "nil timestamp pointer",
+			fieldType:   enumutils.FieldTypeTimestamp,
+			operation:   enumutils.OperationEqual,
+			filterValue: (*time.Time)(nil),
+			candidate:   time.Now(),
+			wantErr:     enumutils.ErrInvalidFilterValue,
+			wantMessage: "invalid TIMESTAMP filter value: *time.Time is not a timestamp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.Evaluate(tt.fieldType, tt.operation, tt.filterValue, tt.candidate)
+			assert.False(t, got)
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+			if err != nil {
+				assert.Equal(t, tt.wantMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestEvaluate_FilterError(t *testing.T) {
+	_, err := enumutils.Evaluate(enumutils.FieldTypeNumber, enumutils.OperationEqual, "ten", 10)
+
+	var filterErr *enumutils.FilterError
+	if assert.True(t, errors.As(err, &filterErr)) {
+		assert.Equal(t, enumutils.FieldTypeNumber, filterErr.FieldType)
+		assert.Equal(t, enumutils.OperationEqual, filterErr.Operation)
+		assert.Equal(t, "ten", filterErr.Value)
+	}
+}
+
+func TestFieldType_SupportsOperation(t *testing.T) {
+	tests := []struct {
+		fieldType enumutils.FieldType
+		supported []enumutils.Operation
+	}{
+		{
+			fieldType: enumutils.FieldTypeBoolean,
+			supported: []enumutils.Operation{enumutils.OperationEqual, enumutils.OperationIn},
+		},
+		{
+			fieldType: enumutils.FieldTypeTimestamp,
+			supported: []enumutils.Operation{
+				enumutils.OperationLessThan, enumutils.OperationLessThanOrEqualTo, enumutils.OperationEqual,
+				enumutils.OperationGreaterThan, enumutils.OperationGreaterThanOrEqualTo, enumutils.OperationIn,
+			},
+		},
+		{
+			fieldType: enumutils.FieldTypeNumber,
+			supported: []enumutils.Operation{
+				enumutils.OperationLessThan, enumutils.OperationLessThanOrEqualTo, enumutils.OperationEqual,
+				enumutils.OperationGreaterThan, enumutils.OperationGreaterThanOrEqualTo, enumutils.OperationIn,
+			},
+		},
+		{
+			fieldType: enumutils.FieldTypeInteger,
+			supported: []enumutils.Operation{
+				enumutils.OperationLessThan, enumutils.OperationLessThanOrEqualTo, enumutils.OperationEqual,
+				enumutils.OperationGreaterThan, enumutils.OperationGreaterThanOrEqualTo, enumutils.OperationIn,
+			},
+		},
+		{
+			fieldType: enumutils.FieldTypeString,
+			supported: []enumutils.Operation{enumutils.OperationEqual, enumutils.OperationIn, enumutils.OperationContains},
+		},
+		{
+			fieldType: enumutils.FieldType("DATE"),
+			supported: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldType.String(), func(t *testing.T) {
+			assert.Equal(t, tt.supported, tt.fieldType.Operations())
+			for _, operation := range enumutils.AllOperation {
+				want := false
+				for _, supported := range tt.supported {
+					want = want || supported == operation
+				}
+				assert.Equal(t, want, tt.fieldType.SupportsOperation(operation), operation)
+			}
+		})
+	}
+
+	// every field type has an entry in the table
+	for _, fieldType := range enumutils.AllFieldType {
+		assert.NotEmpty(t, fieldType.Operations(), fieldType)
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	assert.Nil(t, enumutils.ValidateFilter(enumutils.FieldTypeString, enumutils.OperationContains))
+	assert.Nil(t, enumutils.ValidateFilter(enumutils.FieldTypeTimestamp, enumutils.OperationGreaterThan))
+
+	err := enumutils.ValidateFilter(enumutils.FieldTypeString, enumutils.OperationLessThan)
+	assert.True(t, errors.Is(err, enumutils.ErrUnsupportedOperation))
+	assert.Equal(t, "LESS_THAN is not supported for STRING filters, use one of EQUAL, IN, CONTAINS", err.Error())
+
+	err = enumutils.ValidateFilter(enumutils.FieldTypeNumber, enumutils.OperationContains)
+	assert.True(t, errors.Is(err, enumutils.ErrUnsupportedOperation))
+
+	assert.True(t, errors.Is(enumutils.ValidateFilter("", enumutils.OperationEqual), enumutils.ErrInvalidValue))
+	assert.True(t, errors.Is(enumutils.ValidateFilter(enumutils.FieldTypeString, ""), enumutils.ErrInvalidValue))
+
+	// modifying the returned operations must not modify the table
+	operations := enumutils.FieldTypeBoolean.Operations()
+	operations[0] = enumutils.OperationContains
+	assert.False(t, enumutils.FieldTypeBoolean.SupportsOperation(enumutils.OperationContains))
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,236 @@
+package enumutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// FilterParam is a single filter applied to a field of a list query e.g
+// {"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation":
+// "GREATER_THAN", "fieldValue": 18}
+type FilterParam struct {
+	FieldName           string      `json:"fieldName"`
+	FieldType           FieldType   `json:"fieldType"`
+	ComparisonOperation Operation   `json:"comparisonOperation"`
+	FieldValue          interface{} `json:"fieldValue"`
+}
+
+// Validate checks that the filter names a field, that its operation is
+// supported by its field type and that its value can be converted to the
+// field type
+func (p FilterParam) Validate() error {
+	if p.FieldName == "" {
+		return errors.New("a filter must have a field name")
+	}
+	if err := ValidateFilter(p.FieldType, p.ComparisonOperation); err != nil {
+		return fmt.Errorf("%s: %w", p.FieldName, err)
+	}
+	if _, err := coerceFilterValue(p.FieldType, p.ComparisonOperation, p.FieldValue); err != nil {
+		return fmt.Errorf("%s: %w", p.FieldName, err)
+	}
+	return nil
+}
+
+// Matches reports whether the field of the record named by the filter
+// matches it. A record without the field does not match.
+func (p FilterParam) Matches(record map[string]interface{}) (bool, error) {
+	matched, err := Evaluate(p.FieldType, p.ComparisonOperation, p.FieldValue, record[p.FieldName])
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", p.FieldName, err)
+	}
+	return matched, nil
+}
+
+// UnmarshalJSON decodes and validates a filter. Numbers in the field value
+// are decoded as json.Number so that large integers keep their precision.
+func (p *FilterParam) UnmarshalJSON(data []byte) error {
+	var decoded filterParamJSON
+	if err := decodeJSONNumbers(data, &decoded); err != nil {
+		return err
+	}
+
+	filter := decoded.filterParam()
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	*p = filter
+	return nil
+}
+
+// UnmarshalGQL decodes and validates a filter from a GraphQL input object
+func (p *FilterParam) UnmarshalGQL(v interface{}) error {
+	return unmarshalGQLObject(p, v)
+}
+
+// MarshalGQL writes the filter to the supplied writer as a JSON object. An
+// invalid filter, one that Validate rejects, is written as null.
+func (p FilterParam) MarshalGQL(w io.Writer) {
+	marshalGQLObject(w, p, p.Validate())
+}
+
+// FilterSet combines filters and nested groups of filters with AND or OR e.g
+// "age > 18 AND (county = Nairobi OR county = Mombasa)" is a FilterSet with
+// one filter and one group
+type FilterSet struct {
+	// Logic defaults to AND when it is empty
+	Logic   FilterLogic   `json:"logic,omitempty"`
+	Filters []FilterParam `json:"filters,omitempty"`
+	Groups  []FilterSet   `json:"groups,omitempty"`
+}
+
+// Validate checks the logic, filters and groups of the set. Errors name the
+// position of the invalid filter e.g "groups[0].filters[1]: ...".
+func (s FilterSet) Validate() error {
+	if s.Logic != "" && !s.Logic.IsValid() {
+		return fmt.Errorf("logic: %w", newInvalidEnumError(s.Logic.EnumName(), s.Logic.String(), s.Logic.Values()))
+	}
+	for i, filter := range s.Filters {
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf("filters[%d]: %w", i, err)
+		}
+	}
+	for i, group := range s.Groups {
+		if err := group.Validate(); err != nil {
+			return fmt.Errorf("groups[%d].%w", i, err)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the record matches the set. An empty set matches
+// every record.
+func (s FilterSet) Matches(record map[string]interface{}) (bool, error) {
+	if err := s.Validate(); err != nil {
+		return false, err
+	}
+	return s.matches(record)
+}
+
+func (s FilterSet) matches(record map[string]interface{}) (bool, error) {
+	if len(s.Filters) == 0 && len(s.Groups) == 0 {
+		return true, nil
+	}
+
+	// with OR the first match decides the result, with AND the first
+	// mismatch does
+	or := s.Logic == FilterLogicOr
+	for _, filter := range s.Filters {
+		matched, err := filter.Matches(record)
+		if err != nil {
+			return false, err
+		}
+		if matched == or {
+			return or, nil
+		}
+	}
+	for _, group := range s.Groups {
+		matched, err := group.matches(record)
+		if err != nil {
+			return false, err
+		}
+		if matched == or {
+			return or, nil
+		}
+	}
+	return !or, nil
+}
+
+// UnmarshalJSON decodes and validates a filter set. The whole set is
+// decoded before it is validated so that errors name the position of the
+// invalid filter.
+func (s *FilterSet) UnmarshalJSON(data []byte) error {
+	var decoded filterSetJSON
+	if err := decodeJSONNumbers(data, &decoded); err != nil {
+		return err
+	}
+
+	set := decoded.filterSet()
+	if err := set.Validate(); err != nil {
+		return err
+	}
+	*s = set
+	return nil
+}
+
+// UnmarshalGQL decodes and validates a filter set from a GraphQL input object
+func (s *FilterSet) UnmarshalGQL(v interface{}) error {
+	return unmarshalGQLObject(s, v)
+}
+
+// MarshalGQL writes the filter set to the supplied writer as a JSON object.
+// An invalid filter set, one that Validate rejects, is written as null.
+func (s FilterSet) MarshalGQL(w io.Writer) {
+	marshalGQLObject(w, s, s.Validate())
+}
+
+// filterParamJSON mirrors FilterParam without its validating UnmarshalJSON
+type filterParamJSON struct {
+	FieldName           string      `json:"fieldName"`
+	FieldType           string      `json:"fieldType"`
+	ComparisonOperation string      `json:"comparisonOperation"`
+	FieldValue          interface{} `json:"fieldValue"`
+}
+
+func (p filterParamJSON) filterParam() FilterParam {
+	return FilterParam{
+		FieldName:           p.FieldName,
+		FieldType:           FieldType(p.FieldType),
+		ComparisonOperation: Operation(p.ComparisonOperation),
+		FieldValue:          p.FieldValue,
+	}
+}
+
+// filterSetJSON mirrors FilterSet without its validating UnmarshalJSON
+type filterSetJSON struct {
+	Logic   string            `json:"logic"`
+	Filters []filterParamJSON `json:"filters"`
+	Groups  []filterSetJSON   `json:"groups"`
+}
+
+func (s filterSetJSON) filterSet() FilterSet {
+	set := FilterSet{Logic: FilterLogic(s.Logic)}
+	for _, filter := range s.Filters {
+		set.Filters = append(set.Filters, filter.filterParam())
+	}
+	for _, group := range s.Groups {
+		set.Groups = append(set.Groups, group.filterSet())
+	}
+	return set
+}
+
+// decodeJSONNumbers decodes JSON numbers as json.Number rather than float64
+func decodeJSONNumbers(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// unmarshalGQLObject decodes a GraphQL input object, which gqlgen supplies as
+// a map, by way of its JSON representation
+func unmarshalGQLObject(dst interface{}, v interface{}) error {
+	if _, ok := v.(map[string]interface{}); !ok {
+		return fmt.Errorf("%T is not an input object", v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// marshalGQLObject writes a GraphQL output object as JSON. MarshalGQL cannot
+// return an error, so an object that failed validation is written as null
+// rather than as a partial or invalid object.
+func marshalGQLObject(w io.Writer, v interface{}, validationErr error) {
+	data := []byte("null")
+	if validationErr == nil {
+		if encoded, err := json.Marshal(v); err == nil {
+			data = encoded
+		}
+	}
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,283 @@
+package enumutils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterParam_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    enumutils.FilterParam
+		wantErr string
+	}{
+		{
+			name: "valid filter",
+			data: `{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "GREATER_THAN", "fieldValue": 9007199254740993}`,
+			want: enumutils.FilterParam{
+				FieldName:           "age",
+				FieldType:           enumutils.FieldTypeInteger,
+				ComparisonOperation: enumutils.OperationGreaterThan,
+				FieldValue:          json.Number("9007199254740993"),
+			},
+		},
+		{
+			name:    "missing field name",
+			data:    `{"fieldType": "STRING", "comparisonOperation": "EQUAL", "fieldValue": "x"}`,
+			wantErr: "a filter must have a field name",
+		},
+		{
+			name:    "invalid field type",
+			data:    `{"fieldName": "age", "fieldType": "INTEGR", "comparisonOperation": "EQUAL", "fieldValue": 1}`,
+			wantErr: "age: INTEGR is not a valid FieldType",
+		},
+		{
+			name:    "unsupported operation",
+			data:    `{"fieldName": "active", "fieldType": "BOOLEAN", "comparisonOperation": "CONTAINS", "fieldValue": true}`,
+			wantErr: "active: CONTAINS is not supported for BOOLEAN filters",
+		},
+		{
+			name:    "value of the wrong type",
+			data:    `{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "EQUAL", "fieldValue": "eighteen"}`,
+			wantErr: "age: invalid INTEGER filter value",
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"fieldName": `,
+			wantErr: "unexpected end of JSON input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got enumutils.FilterParam
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilterParam_GQL(t *testing.T) {
+	var got enumutils.FilterParam
+	err := got.UnmarshalGQL(map[string]interface{}{
+		"fieldName":           "county",
+		"fieldType":           "STRING",
+		"comparisonOperation": "IN",
+		"fieldValue":          []interface{}{"Nairobi", "Mombasa"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, enumutils.FieldTypeString, got.FieldType)
+	assert.Equal(t, enumutils.OperationIn, got.ComparisonOperation)
+
+	var buf bytes.Buffer
+	got.MarshalGQL(&buf)
+	assert.JSONEq(t, `{"fieldName": "county", "fieldType": "STRING", "comparisonOperation": "IN", "fieldValue": ["Nairobi", "Mombasa"]}`, buf.String())
+
+	err = got.UnmarshalGQL("county")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "string is not an input object")
+	}
+
+	err = got.UnmarshalGQL(map[string]interface{}{
+		"fieldName":           "county",
+		"fieldType":           "STRING",
+		"comparisonOperation": "IN",
+		"fieldValue":          "Nairobi",
+	})
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidFilterValue))
+
+	// invalid filters are written as null
+	for _, invalid := range []enumutils.FilterParam{
+		{FieldName: "x", FieldType: enumutils.FieldTypeString, ComparisonOperation: enumutils.OperationEqual, FieldValue: make(chan int)},
+		{FieldName: "x", ComparisonOperation: enumutils.OperationEqual, FieldValue: "a"},
+		{FieldName: "x", FieldType: enumutils.FieldTypeString, ComparisonOperation: "LIKE", FieldValue: "a"},
+		{FieldType: enumutils.FieldTypeString, ComparisonOperation: enumutils.OperationEqual, FieldValue: "a"},
+	} {
+		buf.Reset()
+		invalid.MarshalGQL(&buf)
+		assert.Equal(t, "null", buf.String())
+	}
+}
+
+func TestFilterParam_Matches(t *testing.T) {
+	filter := enumutils.FilterParam{
+		FieldName:           "age",
+		FieldType:           enumutils.FieldTypeInteger,
+		ComparisonOperation: enumutils.OperationGreaterThanOrEqualTo,
+		FieldValue:          18,
+	}
+
+	matched, err := filter.Matches(map[string]interface{}{"age": 18})
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	matched, err = filter.Matches(map[string]interface{}{"name": "Juma"})
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	_, err = filter.Matches(map[string]interface{}{"age": "old"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "age: invalid INTEGER filter value")
+	}
+}
+
+func TestFilterSet_Matches(t *testing.T) {
+	data := `{
+		"filters": [
+			{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "GREATER_THAN", "fieldValue": 18}
+		],
+		"groups": [
+			{
+				"logic": "OR",
+				"filters": [
+					{"fieldName": "county", "fieldType": "STRING", "comparisonOperation": "EQUAL", "fieldValue": "Nairobi"},
+					{"fieldName": "county", "fieldType": "STRING", "comparisonOperation": "EQUAL", "fieldValue": "Mombasa"}
+				]
+			}
+		]
+	}`
+
+	var set enumutils.FilterSet
+	assert.NoError(t, json.Unmarshal([]byte(data), &set))
+
+	tests := []struct {
+		name   string
+		record map[string]interface{}
+		want   bool
+	}{
+		{
+			name:   "all match",
+			record: map[string]interface{}{"age": 30, "county": "Mombasa"},
+			want:   true,
+		},
+		{
+			name:   "group does not match",
+			record: map[string]interface{}{"age": 30, "county": "Kisumu"},
+			want:   false,
+		},
+		{
+			name:   "filter does not match",
+			record: map[string]interface{}{"age": 12, "county": "Nairobi"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := set.Matches(tt.record)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	matched, err := enumutils.FilterSet{}.Matches(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	or := enumutils.FilterSet{
+		Logic: enumutils.FilterLogicOr,
+		Groups: []enumutils.FilterSet{
+			{Filters: []enumutils.FilterParam{{
+				FieldName:           "active",
+				FieldType:           enumutils.FieldTypeBoolean,
+				ComparisonOperation: enumutils.OperationEqual,
+				FieldValue:          true,
+			}}},
+		},
+	}
+	matched, err = or.Matches(map[string]interface{}{"active": true})
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	matched, err = or.Matches(map[string]interface{}{"active": false})
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	_, err = or.Matches(map[string]interface{}{"active": 1})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "active: invalid BOOLEAN filter value")
+	}
+}
+
+func TestFilterSet_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "invalid logic",
+			data:    `{"logic": "XOR"}`,
+			wantErr: "logic: XOR is not a valid FilterLogic",
+		},
+		{
+			name:    "invalid filter",
+			data:    `{"filters": [{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "CONTAINS", "fieldValue": 1}]}`,
+			wantErr: "filters[0]: age: CONTAINS is not supported for INTEGER filters",
+		},
+		{
+			name: "invalid filter in a nested group",
+			data: `{"groups": [{"filters": [
+				{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "EQUAL", "fieldValue": 1},
+				{"fieldName": "dob", "fieldType": "TIMESTAMP", "comparisonOperation": "EQUAL", "fieldValue": "yesterday"}
+			]}]}`,
+			wantErr: "groups[0].filters[1]: dob: invalid TIMESTAMP filter value",
+		},
+		{
+			name:    "wrong JSON type",
+			data:    `{"filters": {}}`,
+			wantErr: "cannot unmarshal object",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var set enumutils.FilterSet
+			err := json.Unmarshal([]byte(tt.data), &set)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	_, err := enumutils.FilterSet{Logic: "XOR"}.Matches(nil)
+	assert.Error(t, err)
+}
+
+func TestFilterSet_GQL(t *testing.T) {
+	var set enumutils.FilterSet
+	err := set.UnmarshalGQL(map[string]interface{}{
+		"logic": "OR",
+		"filters": []interface{}{
+			map[string]interface{}{
+				"fieldName":           "score",
+				"fieldType":           "NUMBER",
+				"comparisonOperation": "LESS_THAN",
+				"fieldValue":          2.5,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, enumutils.FilterLogicOr, set.Logic)
+	assert.Equal(t, json.Number("2.5"), set.Filters[0].FieldValue)
+
+	var buf bytes.Buffer
+	set.MarshalGQL(&buf)
+	assert.JSONEq(t, `{"logic": "OR", "filters": [{"fieldName": "score", "fieldType": "NUMBER", "comparisonOperation": "LESS_THAN", "fieldValue": 2.5}]}`, buf.String())
+
+	buf.Reset()
+	enumutils.FilterSet{Logic: enumutils.FilterLogicOr, Filters: []enumutils.FilterParam{{FieldName: "score"}}}.MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+
+	assert.Error(t, set.UnmarshalGQL([]interface{}{}))
+}
@@ -0,0 +1,65 @@
+package enumutils
+
+import (
+	"fmt"
+	"io"
+)
+
+// Enum is the constraint satisfied by every enum type in this package.
+//
+// UnmarshalGQL has a pointer receiver so it is not part of a value's method
+// set; helpers that need it take an additional EnumPointer type parameter,
+// which the compiler infers e.g `Parse[Gender]("male")`.
+type Enum interface {
+	~string
+	String() string
+	IsValid() bool
+	Values() []string
+	EnumName() string
+	MarshalGQL(w io.Writer)
+}
+
+// EnumPointer is the constraint satisfied by a pointer to an Enum
+type EnumPointer[T Enum] interface {
+	*T
+	UnmarshalGQL(v interface{}) error
+}
+
+// Parse converts the supplied string into an enum value of type T. It returns
+// the same error as the enum's UnmarshalGQL when the string is not valid.
+func Parse[T Enum, P EnumPointer[T]](s string) (T, error) {
+	var e T
+	if err := P(&e).UnmarshalGQL(s); err != nil {
+		var zero T
+		return zero, err
+	}
+	return e, nil
+}
+
+// MustParse is like Parse but panics if the string is not a valid value of T.
+// It is intended for values that are known to be valid e.g in tests or
+// package level variables.
+func MustParse[T Enum, P EnumPointer[T]](s string) T {
+	e, err := Parse[T, P](s)
+	if err != nil {
+		panic(fmt.Sprintf("enumutils: %s", err))
+	}
+	return e
+}
+
+// Values returns all the valid values of T in declaration order
+func Values[T Enum]() []T {
+	var zero T
+	values := zero.Values()
+
+	enums := make([]T, 0, len(values))
+	for _, v := range values {
+		enums = append(enums, T(v))
+	}
+	return enums
+}
+
+// Contains returns true if the supplied string is a valid value of T
+func Contains[T Enum](s string) bool {
+	return T(s).IsValid()
+}
@@ -0,0 +1,76 @@
+package enumutils_test
+
+import (
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    enumutils.Gender
+		wantErr string
+	}{
+		{
+			name:  "Happy case: valid gender",
+			input: "female",
+			want:  enumutils.GenderFemale,
+		},
+		{
+			name:    "Sad case: invalid gender",
+			input:   "FEMALE",
+			want:    "",
+			wantErr: "FEMALE is not a valid Gender, did you mean female?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.Parse[enumutils.Gender](tt.input)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	assert.Equal(t, enumutils.OperationIn, enumutils.MustParse[enumutils.Operation]("IN"))
+	assert.Equal(t, enumutils.SenderIDBewell, enumutils.MustParse[enumutils.SenderID]("BEWELL"))
+	assert.Panics(t, func() {
+		enumutils.MustParse[enumutils.SenderID]("bewell")
+	})
+}
+
+func TestValues(t *testing.T) {
+	assert.Equal(t, enumutils.AllGender, enumutils.Values[enumutils.Gender]())
+	assert.Equal(t, enumutils.AllFieldType, enumutils.Values[enumutils.FieldType]())
+	assert.Equal(t, enumutils.AllOperation, enumutils.Values[enumutils.Operation]())
+	assert.Equal(t, enumutils.AllSortOrder, enumutils.Values[enumutils.SortOrder]())
+	assert.Equal(t, enumutils.AllContentType, enumutils.Values[enumutils.ContentType]())
+	assert.Equal(t, enumutils.AllLanguage, enumutils.Values[enumutils.Language]())
+	assert.Equal(t, enumutils.AllPractitionerSpecialty, enumutils.Values[enumutils.PractitionerSpecialty]())
+	assert.Equal(t, enumutils.AllCalendarView, enumutils.Values[enumutils.CalendarView]())
+	assert.Equal(t, enumutils.AllAddressType, enumutils.Values[enumutils.AddressType]())
+	assert.Equal(t, enumutils.AllIdentificationDocType, enumutils.Values[enumutils.IdentificationDocType]())
+	assert.Equal(t, enumutils.AllSenderID, enumutils.Values[enumutils.SenderID]())
+
+	// the returned slice is a copy, modifying it must not affect later calls
+	values := enumutils.Values[enumutils.SortOrder]()
+	values[0] = enumutils.SortOrderDesc
+	assert.Equal(t, enumutils.SortOrderAsc, enumutils.Values[enumutils.SortOrder]()[0])
+}
+
+func TestContains(t *testing.T) {
+	assert.True(t, enumutils.Contains[enumutils.Language]("sw"))
+	assert.True(t, enumutils.Contains[enumutils.PractitionerSpecialty]("UROLOGY"))
+	assert.False(t, enumutils.Contains[enumutils.Language]("SW"))
+	assert.False(t, enumutils.Contains[enumutils.AddressType](""))
+}
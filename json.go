@@ -0,0 +1,35 @@
+package enumutils
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// unmarshalJSON decodes a JSON value into an enum and validates it the same
+// way UnmarshalGQL does, so that REST and GraphQL inputs are rejected with
+// identical errors. A JSON null leaves the enum unchanged.
+func unmarshalJSON[T Enum, P EnumPointer[T]](e P, data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(v)
+}
+
+// marshalJSON renders an enum as a JSON string, rejecting invalid values with
+// the same error as driverValue so that JSON written by the package can always
+// be read back by it. The zero value, e.g an unset field, is written as null,
+// which unmarshalJSON reads back as the zero value.
+func marshalJSON[T Enum](e T) ([]byte, error) {
+	if e == "" {
+		return []byte("null"), nil
+	}
+	if !e.IsValid() {
+		return nil, newInvalidEnumError(e.EnumName(), e.String(), e.Values())
+	}
+	return json.Marshal(e.String())
+}
@@ -0,0 +1,156 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type patient struct {
+	Name     string                            `json:"name"`
+	Gender   enumutils.Gender                  `json:"gender"`
+	Language *enumutils.Language               `json:"language,omitempty"`
+	IDTypes  []enumutils.IdentificationDocType `json:"idTypes,omitempty"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    patient
+		wantErr string
+	}{
+		{
+			name:    "Happy case: valid values",
+			payload: `{"name": "Jane", "gender": "female", "language": "sw", "idTypes": ["PASSPORT", "NATIONALID"]}`,
+			want: patient{
+				Name:     "Jane",
+				Gender:   enumutils.GenderFemale,
+				Language: func() *enumutils.Language { l := enumutils.LanguageSw; return &l }(),
+				IDTypes:  []enumutils.IdentificationDocType{enumutils.IdentificationDocTypePassport, enumutils.IdentificationDocTypeNationalid},
+			},
+		},
+		{
+			name:    "Happy case: null leaves the value unchanged",
+			payload: `{"name": "Jane", "gender": null, "language": null}`,
+			want:    patient{Name: "Jane"},
+		},
+		{
+			name:    "Sad case: wrong case",
+			payload: `{"gender": "MALE"}`,
+			wantErr: "MALE is not a valid Gender, did you mean male?",
+		},
+		{
+			name:    "Sad case: empty string",
+			payload: `{"gender": ""}`,
+			wantErr: " is not a valid Gender",
+		},
+		{
+			name:    "Sad case: not a string",
+			payload: `{"gender": 1}`,
+			wantErr: "enums must be strings",
+		},
+		{
+			name:    "Sad case: invalid value in a list",
+			payload: `{"idTypes": ["PASSPORT", "DRIVING_LICENCE"]}`,
+			wantErr: "DRIVING_LICENCE is not a valid IdentificationDocType",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got patient
+			err := json.Unmarshal([]byte(tt.payload), &got)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnmarshalJSON_MatchesUnmarshalGQL(t *testing.T) {
+	inputs := []interface{}{"this is not valid", "", 42, true, []string{"ASC"}}
+	for _, input := range inputs {
+		data, err := json.Marshal(input)
+		assert.Nil(t, err)
+
+		var gql, rest enumutils.SortOrder
+		gqlErr := gql.UnmarshalGQL(input)
+		restErr := json.Unmarshal(data, &rest)
+		if assert.Error(t, gqlErr) && assert.Error(t, restErr) {
+			assert.Equal(t, gqlErr.Error(), restErr.Error())
+		}
+	}
+}
+
+func TestUnmarshalJSON_AllEnums(t *testing.T) {
+	assertJSONRoundTrip(t, enumutils.AllGender)
+	assertJSONRoundTrip(t, enumutils.AllFieldType)
+	assertJSONRoundTrip(t, enumutils.AllOperation)
+	assertJSONRoundTrip(t, enumutils.AllSortOrder)
+	assertJSONRoundTrip(t, enumutils.AllContentType)
+	assertJSONRoundTrip(t, enumutils.AllLanguage)
+	assertJSONRoundTrip(t, enumutils.AllPractitionerSpecialty)
+	assertJSONRoundTrip(t, enumutils.AllCalendarView)
+	assertJSONRoundTrip(t, enumutils.AllAddressType)
+	assertJSONRoundTrip(t, enumutils.AllIdentificationDocType)
+	assertJSONRoundTrip(t, enumutils.AllSenderID)
+}
+
+func assertJSONRoundTrip[T enumutils.Enum](t *testing.T, values []T) {
+	t.Helper()
+
+	data, err := json.Marshal(values)
+	assert.Nil(t, err)
+
+	var got []T
+	assert.Nil(t, json.Unmarshal(data, &got))
+	assert.Equal(t, values, got)
+
+	var invalid T
+	assert.Error(t, json.Unmarshal([]byte(`"not a valid value"`), &invalid))
+}
+
+func TestMarshalJSON(t *testing.T) {
+	got, err := json.Marshal(patient{Name: "John", Gender: enumutils.GenderPreferNotToSay})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name": "John", "gender": "prefer_not_to_say"}`, string(got))
+
+	got, err = json.Marshal(map[enumutils.Language]string{enumutils.LanguageEn: "Hello"})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"en": "Hello"}`, string(got))
+}
+
+func TestMarshalJSON_RoundTrip(t *testing.T) {
+	want := patient{Name: "Wanjiru", Gender: enumutils.GenderFemale}
+	data, err := json.Marshal(want)
+	assert.Nil(t, err)
+
+	var got patient
+	assert.Nil(t, json.Unmarshal(data, &got))
+	assert.Equal(t, want, got)
+
+	// an unset enum is written as null and read back as the zero value
+	unset := patient{Name: "Wanjiru"}
+	data, err = json.Marshal(unset)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name": "Wanjiru", "gender": null}`, string(data))
+	got = patient{}
+	assert.Nil(t, json.Unmarshal(data, &got))
+	assert.Equal(t, unset, got)
+
+	invalid := enumutils.Gender("robot")
+	_, err = json.Marshal(patient{Name: "Wanjiru", Gender: invalid})
+	var invalidErr *enumutils.InvalidEnumError
+	if assert.True(t, errors.As(err, &invalidErr), "got %v", err) {
+		_, valueErr := invalid.Value()
+		assert.Equal(t, valueErr, invalidErr)
+	}
+}
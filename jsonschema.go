@@ -0,0 +1,85 @@
+package enumutils
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect written by WriteJSONSchema
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is the JSON Schema of an enum. It is also a valid OpenAPI 3
+// schema object.
+type JSONSchema struct {
+	Type        string   `json:"type"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum"`
+
+	// VarNames are the names of the Go constants, used by code generators to
+	// name the values
+	VarNames []string `json:"x-enum-varnames,omitempty"`
+
+	// Descriptions are the descriptions of the values in the same order as
+	// Enum. They are omitted when no value has a description.
+	Descriptions []string `json:"x-enum-descriptions,omitempty"`
+}
+
+// NewJSONSchema returns the JSON Schema of the supplied enum
+func NewJSONSchema(info EnumInfo) JSONSchema {
+	schema := JSONSchema{
+		Type:        "string",
+		Title:       info.Name,
+		Description: info.Description,
+		Enum:        info.Strings(),
+		VarNames:    make([]string, 0, len(info.Values)),
+	}
+
+	descriptions := make([]string, 0, len(info.Values))
+	described := false
+	for _, value := range info.Values {
+		schema.VarNames = append(schema.VarNames, value.Name)
+		descriptions = append(descriptions, value.Description)
+		described = described || value.Description != ""
+	}
+	if described {
+		schema.Descriptions = descriptions
+	}
+
+	return schema
+}
+
+// WriteJSONSchema writes a JSON Schema document that defines each of the
+// supplied enums under $defs e.g {"$ref": "#/$defs/Gender"}
+func WriteJSONSchema(w io.Writer, infos []EnumInfo) error {
+	return writeIndentedJSON(w, map[string]interface{}{
+		"$schema": JSONSchemaDraft,
+		"$defs":   jsonSchemas(infos),
+	})
+}
+
+// WriteOpenAPIComponents writes an OpenAPI 3 document fragment that defines
+// each of the supplied enums under components.schemas, ready to be merged into
+// an API definition e.g {"$ref": "#/components/schemas/Gender"}
+func WriteOpenAPIComponents(w io.Writer, infos []EnumInfo) error {
+	return writeIndentedJSON(w, map[string]interface{}{
+		"components": map[string]interface{}{
+			"schemas": jsonSchemas(infos),
+		},
+	})
+}
+
+func jsonSchemas(infos []EnumInfo) map[string]JSONSchema {
+	schemas := make(map[string]JSONSchema, len(infos))
+	for _, info := range infos {
+		schemas[info.Name] = NewJSONSchema(info)
+	}
+	return schemas
+}
+
+func writeIndentedJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,71 @@
+package enumutils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJSONSchema(t *testing.T) {
+	gender, _ := enumutils.InfoOf[enumutils.Gender]()
+	schema := enumutils.NewJSONSchema(gender)
+
+	assert.Equal(t, "string", schema.Type)
+	assert.Equal(t, "Gender", schema.Title)
+	assert.Equal(t, gender.Description, schema.Description)
+	assert.Equal(t, gender.Strings(), schema.Enum)
+	assert.Equal(t, "GenderPreferNotToSay", schema.VarNames[len(schema.VarNames)-1])
+	assert.Nil(t, schema.Descriptions, "no gender value has a description")
+
+	for _, value := range schema.Enum {
+		assert.True(t, enumutils.Gender(value).IsValid())
+	}
+	assert.Len(t, schema.Enum, len(enumutils.AllGender))
+
+	operation, _ := enumutils.InfoOf[enumutils.Operation]()
+	schema = enumutils.NewJSONSchema(operation)
+	assert.Len(t, schema.Descriptions, len(enumutils.AllOperation))
+	assert.Equal(t, "OperationLessThan represents < in a GraphQL enum", schema.Descriptions[0])
+}
+
+func TestWriteJSONSchema(t *testing.T) {
+	calendarView, _ := enumutils.InfoOf[enumutils.CalendarView]()
+
+	w := &bytes.Buffer{}
+	assert.Nil(t, enumutils.WriteJSONSchema(w, []enumutils.EnumInfo{calendarView}))
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$defs": {
+			"CalendarView": {
+				"type": "string",
+				"title": "CalendarView",
+				"description": "CalendarView is used to determine what view of a calendar to render",
+				"enum": ["DAY", "WEEK"],
+				"x-enum-varnames": ["CalendarViewDay", "CalendarViewWeek"]
+			}
+		}
+	}`, w.String())
+}
+
+func TestWriteOpenAPIComponents(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.Nil(t, enumutils.WriteOpenAPIComponents(w, enumutils.Registered()))
+
+	var document struct {
+		Components struct {
+			Schemas map[string]enumutils.JSONSchema `json:"schemas"`
+		} `json:"components"`
+	}
+	assert.Nil(t, json.Unmarshal(w.Bytes(), &document))
+
+	for _, info := range enumutils.Registered() {
+		schema, ok := document.Components.Schemas[info.Name]
+		if assert.True(t, ok, "missing schema for %s", info.Name) {
+			assert.Equal(t, info.Strings(), schema.Enum)
+		}
+	}
+	assert.Equal(t, enumutils.SenderIDSLADE360.Values(), document.Components.Schemas["SenderID"].Enum)
+}
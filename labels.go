@@ -0,0 +1,78 @@
+package enumutils
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// labelFiles holds a message catalog per language, named after the language
+// e.g labels/sw.json. A catalog maps enum names to the labels of their values:
+//
+//	{"Gender": {"male": "Male", "female": "Female"}}
+//
+//go:embed labels/*.json
+var labelFiles embed.FS
+
+// labelCatalogs are the parsed message catalogs keyed by language, then by
+// enum name and value
+var labelCatalogs = loadLabelCatalogs()
+
+// loadLabelCatalogs parses the embedded message catalogs. The catalogs are
+// part of the package so a malformed one is a programming error.
+func loadLabelCatalogs() map[Language]map[string]map[string]string {
+	entries, err := labelFiles.ReadDir("labels")
+	if err != nil {
+		panic(fmt.Sprintf("enumutils: reading label catalogs: %s", err))
+	}
+
+	catalogs := map[Language]map[string]map[string]string{}
+	for _, entry := range entries {
+		language := Language(strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+		if !language.IsValid() {
+			panic(fmt.Sprintf("enumutils: label catalog %s is not named after a language", entry.Name()))
+		}
+
+		data, err := labelFiles.ReadFile(path.Join("labels", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("enumutils: reading label catalog %s: %s", entry.Name(), err))
+		}
+		catalog := map[string]map[string]string{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("enumutils: parsing label catalog %s: %s", entry.Name(), err))
+		}
+		catalogs[language] = catalog
+	}
+	return catalogs
+}
+
+// LabelOf returns the label of a value of a registered enum in the supplied
+// language e.g LabelOf("Gender", "female", LanguageSw) returns "Mwanamke".
+//
+// The English label is returned when the language has no label for the
+// value, and the value itself when neither does, so that a label is always
+// available to display.
+func LabelOf(enumName, value string, language Language) string {
+	if label, ok := labelCatalogs[language][enumName][value]; ok {
+		return label
+	}
+	if label, ok := labelCatalogs[LanguageEn][enumName][value]; ok {
+		return label
+	}
+	return value
+}
+
+// Labels returns the label of every value of the enum T in the supplied
+// language, in the order of its values e.g for a GraphQL field that lists the
+// options of a dropdown
+func Labels[T Enum](language Language) []string {
+	var zero T
+	values := zero.Values()
+	labels := make([]string, 0, len(values))
+	for _, value := range values {
+		labels = append(labels, LabelOf(zero.EnumName(), value, language))
+	}
+	return labels
+}
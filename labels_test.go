@@ -0,0 +1,142 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		label    string
+		wantText string
+	}{
+		{
+			name:     "English",
+			label:    enumutils.GenderPreferNotToSay.Label(enumutils.LanguageEn),
+			wantText: "Prefer not to say",
+		},
+		{
+			name:     "Swahili",
+			label:    enumutils.GenderFemale.Label(enumutils.LanguageSw),
+			wantText: "Mwanamke",
+		},
+		{
+			name:     "misspelt value",
+			label:    enumutils.PractitionerSpecialtyOphthalmology.Label(enumutils.LanguageEn),
+			wantText: "Ophthalmology",
+		},
+		{
+			name:     "Swahili value with an English name",
+			label:    enumutils.SenderIDBewell.Label(enumutils.LanguageSw),
+			wantText: "Be.Well",
+		},
+		{
+			name:     "English fallback for a language without a catalog",
+			label:    enumutils.UploadPurposeLabReport.Label(enumutils.LanguageFr),
+			wantText: "Lab report",
+		},
+		{
+			name:     "value of an unlabelled enum",
+			label:    enumutils.LabelOf("Colour", "RED", enumutils.LanguageSw),
+			wantText: "RED",
+		},
+		{
+			name:     "invalid value",
+			label:    enumutils.Gender("robot").Label(enumutils.LanguageEn),
+			wantText: "robot",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantText, tt.label)
+		})
+	}
+}
+
+func TestLabels(t *testing.T) {
+	assert.Equal(t, []string{"Kupanda", "Kushuka"}, enumutils.Labels[enumutils.SortOrder](enumutils.LanguageSw))
+	assert.Len(t, enumutils.Labels[enumutils.PractitionerSpecialty](enumutils.LanguageEn), len(enumutils.AllPractitionerSpecialty))
+}
+
+// labelledEnums are the enums of this package, which must have a label for
+// every value in each complete catalog
+var labelledEnums = []interface {
+	EnumName() string
+	Values() []string
+}{
+	enumutils.AddressType(""),
+	enumutils.CalendarView(""),
+	enumutils.ContentType(""),
+	enumutils.FieldType(""),
+	enumutils.FilterLogic(""),
+	enumutils.Gender(""),
+	enumutils.IdentificationDocType(""),
+	enumutils.Language(""),
+	enumutils.NullsOrder(""),
+	enumutils.Operation(""),
+	enumutils.PractitionerSpecialty(""),
+	enumutils.SenderID(""),
+	enumutils.SortOrder(""),
+	enumutils.UploadPurpose(""),
+}
+
+func TestLabelCatalogs(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("labels", "*.json"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	known := map[string][]string{}
+	for _, e := range labelledEnums {
+		known[e.EnumName()] = e.Values()
+	}
+
+	// new enums must be added to labelledEnums
+	source, err := os.ReadFile("enums.go")
+	assert.NoError(t, err)
+	for _, line := range strings.Split(string(source), "\n") {
+		if directive, ok := strings.CutPrefix(line, "//go:generate go run ./cmd/enumgen -type="); ok {
+			for _, name := range strings.Split(strings.TrimSpace(directive), ",") {
+				assert.Contains(t, known, name, "%s is missing from labelledEnums", name)
+			}
+		}
+	}
+
+	for _, file := range files {
+		language := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(language, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			assert.NoError(t, err)
+			catalog := map[string]map[string]string{}
+			assert.NoError(t, json.Unmarshal(data, &catalog))
+
+			for name, labels := range catalog {
+				values, ok := known[name]
+				if !assert.True(t, ok, "%s is not an enum of this package", name) {
+					continue
+				}
+				for value, label := range labels {
+					assert.Contains(t, values, value, "%s is not a %s value", value, name)
+					assert.NotEmpty(t, label, "%s.%s", name, value)
+				}
+			}
+
+			// English is the fallback of every other language and Swahili is
+			// supported by every client
+			if language != enumutils.LanguageEn.String() && language != enumutils.LanguageSw.String() {
+				return
+			}
+			for name, values := range known {
+				for _, value := range values {
+					assert.Contains(t, catalog[name], value, "%s.%s has no %s label", name, value, language)
+				}
+			}
+		})
+	}
+}
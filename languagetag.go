@@ -0,0 +1,213 @@
+package enumutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidLanguageTag is returned when a string is not a well-formed BCP 47
+// language tag
+var ErrInvalidLanguageTag = errors.New("invalid language tag")
+
+// LanguageTag is a BCP 47 language tag such as en-KE or sw-Latn-TZ. It keeps
+// the subtags that follow the language, which Language drops.
+//
+// See: https://www.rfc-editor.org/rfc/rfc5646
+type LanguageTag struct {
+	// Language is the primary language e.g LanguageEn for en-KE
+	Language Language
+
+	// Script is an ISO 15924 script code in title case e.g Latn
+	Script string
+
+	// Region is an ISO 3166-1 country code in upper case e.g KE, or a UN M.49
+	// area code e.g 419
+	Region string
+
+	// Variants are registered variants in lower case e.g 1901
+	Variants []string
+
+	// Extensions are the extension and private use sequences in lower case
+	// e.g u-ca-gregory or x-clinic
+	Extensions []string
+}
+
+// ParseLanguageTag parses a BCP 47 language tag, ignoring case e.g "en-KE",
+// "SW-tz" or "sw-Latn-TZ". POSIX locales such as "en_GB" and "en_GB.UTF-8"
+// are also accepted; their character set and modifier are dropped.
+//
+// The primary language is mapped to a Language with Normalize[Language], so
+// three letter codes such as "swa-KE" are accepted too. Tags with extended
+// language subtags e.g "zh-yue" are rejected.
+func ParseLanguageTag(s string) (LanguageTag, error) {
+	input := strings.TrimSpace(s)
+	if i := strings.IndexAny(input, ".@"); i >= 0 {
+		input = input[:i]
+	}
+	subtags := strings.Split(strings.ToLower(strings.ReplaceAll(input, "_", "-")), "-")
+
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w %q: %s", ErrInvalidLanguageTag, s, reason)
+	}
+	for _, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return LanguageTag{}, invalid("subtags must be 1 to 8 letters or digits")
+		}
+	}
+
+	if len(subtags[0]) < 2 || len(subtags[0]) > 3 || !isAlpha(subtags[0]) {
+		return LanguageTag{}, invalid("the language must be 2 or 3 letters")
+	}
+	language, err := Normalize[Language](subtags[0])
+	if err != nil {
+		return LanguageTag{}, fmt.Errorf("language tag %q: %w", s, err)
+	}
+	tag := LanguageTag{Language: language}
+
+	rest := subtags[1:]
+	if len(rest) > 0 && len(rest[0]) == 3 && isAlpha(rest[0]) {
+		return LanguageTag{}, invalid("extended language subtags are not supported")
+	}
+	if len(rest) > 0 && len(rest[0]) == 4 && isAlpha(rest[0]) {
+		tag.Script = strings.ToUpper(rest[0][:1]) + rest[0][1:]
+		rest = rest[1:]
+	}
+	if len(rest) > 0 && ((len(rest[0]) == 2 && isAlpha(rest[0])) || (len(rest[0]) == 3 && isDigits(rest[0]))) {
+		tag.Region = strings.ToUpper(rest[0])
+		rest = rest[1:]
+	}
+	for len(rest) > 0 && isVariant(rest[0]) {
+		for _, variant := range tag.Variants {
+			if variant == rest[0] {
+				return LanguageTag{}, invalid("variant " + rest[0] + " is repeated")
+			}
+		}
+		tag.Variants = append(tag.Variants, rest[0])
+		rest = rest[1:]
+	}
+
+	singletons := map[string]bool{}
+	for len(rest) > 0 {
+		singleton := rest[0]
+		if len(singleton) != 1 {
+			return LanguageTag{}, invalid(fmt.Sprintf("%s is out of place", singleton))
+		}
+		if singletons[singleton] {
+			return LanguageTag{}, invalid("extension " + singleton + " is repeated")
+		}
+		singletons[singleton] = true
+
+		// private use subtags run to the end of the tag, other extensions to
+		// the next singleton
+		end := len(rest)
+		if singleton != "x" {
+			end = 1
+			for end < len(rest) && len(rest[end]) > 1 {
+				end++
+			}
+		}
+		if end == 1 {
+			return LanguageTag{}, invalid("extension " + singleton + " is empty")
+		}
+		tag.Extensions = append(tag.Extensions, strings.Join(rest[:end], "-"))
+		rest = rest[end:]
+	}
+
+	return tag, nil
+}
+
+// String returns the tag in its canonical form e.g sw-Latn-TZ
+func (t LanguageTag) String() string {
+	subtags := []string{t.Language.String()}
+	if t.Script != "" {
+		subtags = append(subtags, t.Script)
+	}
+	if t.Region != "" {
+		subtags = append(subtags, t.Region)
+	}
+	subtags = append(subtags, t.Variants...)
+	subtags = append(subtags, t.Extensions...)
+	return strings.Join(subtags, "-")
+}
+
+// UnmarshalGQL parses a language tag from a GraphQL string
+func (t *LanguageTag) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return newNotStringError("LanguageTag", v, nil)
+	}
+
+	tag, err := ParseLanguageTag(str)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalGQL writes the tag as a GraphQL string, or null for the zero tag
+func (t LanguageTag) MarshalGQL(w io.Writer) {
+	if t.Language == "" {
+		fmt.Fprint(w, "null")
+		return
+	}
+	fmt.Fprint(w, strconv.Quote(t.String()))
+}
+
+// UnmarshalJSON parses a language tag from a JSON string
+func (t *LanguageTag) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+	return t.UnmarshalGQL(v)
+}
+
+// MarshalJSON writes the tag as a JSON string. The zero tag is written as
+// null, which UnmarshalJSON reads back as the zero tag.
+func (t LanguageTag) MarshalJSON() ([]byte, error) {
+	if t.Language == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// isVariant returns true for 5 to 8 character subtags and 4 character
+// subtags that start with a digit
+func isVariant(subtag string) bool {
+	return len(subtag) >= 5 || (len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9')
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+package enumutils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    enumutils.LanguageTag
+		wantTag string
+		wantErr error
+	}{
+		{
+			name:    "language",
+			input:   "en",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageEn},
+			wantTag: "en",
+		},
+		{
+			name:    "language and region",
+			input:   "SW-tz",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageSw, Region: "TZ"},
+			wantTag: "sw-TZ",
+		},
+		{
+			name:    "POSIX locale",
+			input:   "en_GB.UTF-8@euro",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageEn, Region: "GB"},
+			wantTag: "en-GB",
+		},
+		{
+			name:    "three letter language",
+			input:   "swa-KE",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageSw, Region: "KE"},
+			wantTag: "sw-KE",
+		},
+		{
+			name:    "script and numeric region",
+			input:   "en-latn-419",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageEn, Script: "Latn", Region: "419"},
+			wantTag: "en-Latn-419",
+		},
+		{
+			name:  "variants and extensions",
+			input: "en-GB-oxendict-1994-u-ca-gregory-x-clinic-a",
+			want: enumutils.LanguageTag{
+				Language:   enumutils.LanguageEn,
+				Region:     "GB",
+				Variants:   []string{"oxendict", "1994"},
+				Extensions: []string{"u-ca-gregory", "x-clinic-a"},
+			},
+			wantTag: "en-GB-oxendict-1994-u-ca-gregory-x-clinic-a",
+		},
+		{
+			name:    "private use only",
+			input:   "sw-x-sheng",
+			want:    enumutils.LanguageTag{Language: enumutils.LanguageSw, Extensions: []string{"x-sheng"}},
+			wantTag: "sw-x-sheng",
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "empty subtag",
+			input:   "en--KE",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "subtag too long",
+			input:   "en-abcdefghi",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "not alphanumeric",
+			input:   "en-K!",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "language too long",
+			input:   "english",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "unsupported language",
+			input:   "de-DE",
+			wantErr: enumutils.ErrInvalidValue,
+		},
+		{
+			name:    "extended language",
+			input:   "sw-swc",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "two regions",
+			input:   "en-KE-GB",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "repeated variant",
+			input:   "en-1994-1994",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "repeated extension",
+			input:   "en-u-ca-gregory-u-nu-latn",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+		{
+			name:    "empty extension",
+			input:   "en-u",
+			wantErr: enumutils.ErrInvalidLanguageTag,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.ParseLanguageTag(tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTag, got.String())
+		})
+	}
+}
+
+func TestLanguageTag_Marshalling(t *testing.T) {
+	var tag enumutils.LanguageTag
+	assert.NoError(t, tag.UnmarshalGQL("sw_KE"))
+	assert.Equal(t, enumutils.LanguageSw, tag.Language)
+
+	var buf bytes.Buffer
+	tag.MarshalGQL(&buf)
+	assert.Equal(t, `"sw-KE"`, buf.String())
+
+	err := tag.UnmarshalGQL(42)
+	assert.True(t, errors.Is(err, enumutils.ErrNotString))
+	assert.Error(t, tag.UnmarshalGQL("xx-KE"))
+
+	type profile struct {
+		Language *enumutils.LanguageTag `json:"language"`
+		Fallback enumutils.LanguageTag  `json:"fallback"`
+	}
+	var got profile
+	assert.NoError(t, json.Unmarshal([]byte(`{"language": "en-ke", "fallback": null}`), &got))
+	if assert.NotNil(t, got.Language) {
+		assert.Equal(t, "en-KE", got.Language.String())
+	}
+	assert.Equal(t, enumutils.LanguageTag{}, got.Fallback)
+
+	data, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"language": "en-KE", "fallback": null}`, string(data))
+
+	var roundTrip profile
+	assert.NoError(t, json.Unmarshal(data, &roundTrip))
+	assert.Equal(t, got, roundTrip)
+
+	buf.Reset()
+	enumutils.LanguageTag{}.MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"language": "en-"}`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{"language": ["en"]}`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{"language": `), &got))
+}
@@ -0,0 +1,123 @@
+package enumutils
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// acceptedLanguage is a language range of an Accept-Language header
+type acceptedLanguage struct {
+	// language is empty for the wildcard
+	language Language
+
+	// exact is true when the range has no subtags after the language e.g
+	// "en" but not "en-US"
+	exact bool
+
+	quality float64
+}
+
+// NegotiateLanguage returns the supported language that the client prefers
+// most according to an Accept-Language header e.g
+// NegotiateLanguage("sw-KE, en;q=0.8", LanguageEn) returns LanguageSw.
+//
+// Every language in AllLanguage is supported unless a subset is supplied.
+// Ranges with a region or script match their base language, so en-US matches
+// LanguageEn, and "*" matches any supported language the header does not
+// name. A quality of zero marks a language as unacceptable. The fallback is
+// returned when the header is empty, malformed or names no supported
+// language.
+func NegotiateLanguage(acceptLanguage string, fallback Language, supported ...Language) Language {
+	if len(supported) == 0 {
+		supported = AllLanguage
+	}
+
+	ranges := parseAcceptLanguage(acceptLanguage)
+	// highest quality first, keeping the header order of equal qualities
+	slices.SortStableFunc(ranges, func(a, b acceptedLanguage) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+
+	named := map[Language]bool{}
+	rejected := map[Language]bool{}
+	for _, r := range ranges {
+		if r.language == "" {
+			continue
+		}
+		named[r.language] = true
+		if r.quality == 0 && r.exact {
+			rejected[r.language] = true
+		}
+	}
+
+	for _, r := range ranges {
+		if r.quality == 0 {
+			continue
+		}
+		if r.language != "" {
+			if !rejected[r.language] && slices.Contains(supported, r.language) {
+				return r.language
+			}
+			continue
+		}
+		for _, language := range supported {
+			if !named[language] {
+				return language
+			}
+		}
+	}
+	return fallback
+}
+
+// parseAcceptLanguage returns the language ranges of an Accept-Language
+// header, skipping those that are malformed or whose language is unknown
+func parseAcceptLanguage(header string) []acceptedLanguage {
+	ranges := []acceptedLanguage{}
+	for _, item := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(item, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality, ok := parseQuality(params)
+		if !ok {
+			continue
+		}
+
+		if tag == "*" {
+			ranges = append(ranges, acceptedLanguage{quality: quality})
+			continue
+		}
+		parsed, err := ParseLanguageTag(tag)
+		if err != nil {
+			continue
+		}
+		ranges = append(ranges, acceptedLanguage{
+			language: parsed.Language,
+			exact:    parsed.String() == parsed.Language.String(),
+			quality:  quality,
+		})
+	}
+	return ranges
+}
+
+// parseQuality returns the q parameter of a language range, which defaults
+// to 1
+func parseQuality(params string) (float64, bool) {
+	quality := 1.0
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(param, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "q") {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || q < 0 || q > 1 {
+			return 0, false
+		}
+		quality = q
+	}
+	return quality, true
+}
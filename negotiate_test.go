@@ -0,0 +1,110 @@
+package enumutils_test
+
+import (
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		fallback  enumutils.Language
+		supported []enumutils.Language
+		want      enumutils.Language
+	}{
+		{
+			name:     "empty header",
+			header:   "",
+			fallback: enumutils.LanguageSw,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "single language",
+			header:   "sw",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "base language of a regional tag",
+			header:   "sw-KE",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "highest quality wins",
+			header:   "en-US;q=0.5, sw_TZ;q=0.9",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "header order breaks ties",
+			header:   "en;q=0.8, sw;q=0.8",
+			fallback: enumutils.LanguageSw,
+			want:     enumutils.LanguageEn,
+		},
+		{
+			name:     "unsupported languages are skipped",
+			header:   "de-DE, zh;q=0.9, en;q=0.1",
+			fallback: enumutils.LanguageSw,
+			want:     enumutils.LanguageEn,
+		},
+		{
+			name:      "supported subset",
+			header:    "en, sw;q=0.5",
+			fallback:  enumutils.LanguageSw,
+			supported: []enumutils.Language{enumutils.LanguageSw},
+			want:      enumutils.LanguageSw,
+		},
+		{
+			name:     "wildcard",
+			header:   "de, *;q=0.5",
+			fallback: enumutils.LanguageSw,
+			want:     enumutils.LanguageEn,
+		},
+		{
+			name:     "wildcard skips named languages",
+			header:   "*, en;q=0.1",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "zero quality rejects a language",
+			header:   "en;q=0, en-GB, sw;q=0.2",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "zero quality regional tag does not reject the language",
+			header:   "en-GB;q=0, en-KE;q=0.5",
+			fallback: enumutils.LanguageSw,
+			want:     enumutils.LanguageEn,
+		},
+		{
+			name:     "nothing acceptable",
+			header:   "en;q=0, sw;q=0, *;q=0",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageEn,
+		},
+		{
+			name:     "malformed entries are skipped",
+			header:   "en;q=2, en;q=x, ;q=1, en--GB, sw; Q=0.3",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+		{
+			name:     "other parameters are ignored",
+			header:   "sw;level=1;q=0.4",
+			fallback: enumutils.LanguageEn,
+			want:     enumutils.LanguageSw,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := enumutils.NegotiateLanguage(tt.header, tt.fallback, tt.supported...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package enumutils
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	aliasesMu sync.RWMutex
+
+	// aliases maps an enum name to its folded aliases and their values
+	aliases = map[string]map[string]string{}
+
+	// lenientUnmarshal holds the names of enums whose UnmarshalGQL normalises
+	// input that is not an exact match
+	lenientUnmarshal = map[string]bool{}
+)
+
+func init() {
+	registerAliases(map[string]Gender{
+		"M": GenderMale,
+		"F": GenderFemale,
+		"O": GenderOther,
+		"U": GenderUnknown,
+	})
+	// languages are also known by their names and three letter codes
+	for _, names := range []map[Language]string{LanguageNames, LanguageNativeNames, LanguageAlpha3Codes} {
+		registerAliases(invertLanguageNames(names))
+	}
+	registerAliases(map[string]Language{
+		"swh":    LanguageSw,
+		"fre":    LanguageFr,
+		"Gikuyu": LanguageKi,
+	})
+	registerAliases(map[string]IdentificationDocType{
+		"NATIONAL_ID": IdentificationDocTypeNationalid,
+		"ID":          IdentificationDocTypeNationalid,
+		"MILITARY_ID": IdentificationDocTypeMilitary,
+	})
+	registerAliases(map[string]SortOrder{
+		"ASCENDING":  SortOrderAsc,
+		"DESCENDING": SortOrderDesc,
+	})
+}
+
+func invertLanguageNames(names map[Language]string) map[string]Language {
+	inverted := make(map[string]Language, len(names))
+	for language, name := range names {
+		inverted[name] = language
+	}
+	return inverted
+}
+
+func registerAliases[T Enum](byAlias map[string]T) {
+	for alias, value := range byAlias {
+		RegisterAlias(alias, value)
+	}
+}
+
+// RegisterAlias makes Normalize accept alias as another spelling of value
+// e.g RegisterAlias("M", GenderMale). Aliases are matched ignoring case,
+// surrounding whitespace and the choice of space, hyphen or underscore as a
+// separator.
+//
+// RegisterAlias panics if the value is not valid, the alias is empty or the
+// alias is already registered for a different value.
+func RegisterAlias[T Enum](alias string, value T) {
+	if !value.IsValid() {
+		panic(fmt.Sprintf("enumutils: RegisterAlias called with invalid %s %q", value.EnumName(), value))
+	}
+	folded := foldEnum(alias)
+	if folded == "" {
+		panic(fmt.Sprintf("enumutils: RegisterAlias called with an empty alias for %s", value.EnumName()))
+	}
+
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+
+	enumAliases, ok := aliases[value.EnumName()]
+	if !ok {
+		enumAliases = map[string]string{}
+		aliases[value.EnumName()] = enumAliases
+	}
+	if existing, dup := enumAliases[folded]; dup && existing != value.String() {
+		panic(fmt.Sprintf("enumutils: alias %q is already registered for %s %s", alias, value.EnumName(), existing))
+	}
+	enumAliases[folded] = value.String()
+}
+
+// SetLenientUnmarshal controls whether UnmarshalGQL, UnmarshalJSON and Scan of
+// the enum T accept any input that Normalize accepts. By default they are
+// strict and only accept the exact values of the enum.
+func SetLenientUnmarshal[T Enum](lenient bool) {
+	var zero T
+
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+
+	if lenient {
+		lenientUnmarshal[zero.EnumName()] = true
+	} else {
+		delete(lenientUnmarshal, zero.EnumName())
+	}
+}
+
+// Normalize converts the supplied string to a value of T, accepting input that
+// differs from the canonical value in case, surrounding whitespace or the
+// separator used (space, hyphen or underscore) as well as any registered
+// alias. e.g "Male", " MALE " and "M" all normalise to GenderMale.
+func Normalize[T Enum](s string) (T, error) {
+	var zero T
+	if T(s).IsValid() {
+		return T(s), nil
+	}
+
+	folded := foldEnum(s)
+	for _, value := range zero.Values() {
+		if foldEnum(value) == folded {
+			return T(value), nil
+		}
+	}
+
+	aliasesMu.RLock()
+	value, ok := aliases[zero.EnumName()][folded]
+	aliasesMu.RUnlock()
+	if ok {
+		return T(value), nil
+	}
+
+	return zero, newInvalidEnumError(zero.EnumName(), s, zero.Values())
+}
+
+// unmarshalInvalid is called by UnmarshalGQL with input that is not an exact
+// value of the enum. It normalises the input if the enum has opted in to
+// lenient unmarshalling and otherwise rejects it.
+func unmarshalInvalid[T Enum, P EnumPointer[T]](e P, str string) error {
+	var zero T
+
+	aliasesMu.RLock()
+	lenient := lenientUnmarshal[zero.EnumName()]
+	aliasesMu.RUnlock()
+
+	if lenient {
+		if value, err := Normalize[T](str); err == nil {
+			*e = value
+			return nil
+		}
+	}
+	return newInvalidEnumError(zero.EnumName(), str, zero.Values())
+}
@@ -0,0 +1,152 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    enumutils.Gender
+		wantErr bool
+	}{
+		{
+			name:  "Happy case: exact value",
+			input: "male",
+			want:  enumutils.GenderMale,
+		},
+		{
+			name:  "Happy case: title case",
+			input: "Male",
+			want:  enumutils.GenderMale,
+		},
+		{
+			name:  "Happy case: upper case with whitespace",
+			input: "  FEMALE\n",
+			want:  enumutils.GenderFemale,
+		},
+		{
+			name:  "Happy case: spaces as separators",
+			input: "Prefer not to say",
+			want:  enumutils.GenderPreferNotToSay,
+		},
+		{
+			name:  "Happy case: alias",
+			input: "m",
+			want:  enumutils.GenderMale,
+		},
+		{
+			name:    "Sad case: unknown value",
+			input:   "X",
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: empty value",
+			input:   "  ",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.Normalize[enumutils.Gender](tt.input)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalize_BuiltInAliases(t *testing.T) {
+	language, err := enumutils.Normalize[enumutils.Language]("EN")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.LanguageEn, language)
+
+	language, err = enumutils.Normalize[enumutils.Language]("eng")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.LanguageEn, language)
+
+	language, err = enumutils.Normalize[enumutils.Language]("SWA")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.LanguageSw, language)
+
+	for alias, want := range map[string]enumutils.Language{
+		"Kiswahili": enumutils.LanguageSw,
+		"fre":       enumutils.LanguageFr,
+		"Français":  enumutils.LanguageFr,
+		"gikuyu":    enumutils.LanguageKi,
+		"Gĩkũyũ":    enumutils.LanguageKi,
+		"Dholuo":    enumutils.LanguageLuo,
+		"kin":       enumutils.LanguageRw,
+		"አማርኛ":      enumutils.LanguageAm,
+	} {
+		language, err = enumutils.Normalize[enumutils.Language](alias)
+		assert.Nil(t, err, alias)
+		assert.Equal(t, want, language, alias)
+	}
+
+	docType, err := enumutils.Normalize[enumutils.IdentificationDocType]("national-id")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.IdentificationDocTypeNationalid, docType)
+
+	sortOrder, err := enumutils.Normalize[enumutils.SortOrder]("descending")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.SortOrderDesc, sortOrder)
+
+	// aliases are registered per enum
+	_, err = enumutils.Normalize[enumutils.AddressType]("m")
+	assert.Error(t, err)
+}
+
+func TestRegisterAlias(t *testing.T) {
+	enumutils.RegisterAlias("SLADE", enumutils.SenderIDSLADE360)
+	enumutils.RegisterAlias("slade", enumutils.SenderIDSLADE360)
+
+	got, err := enumutils.Normalize[enumutils.SenderID]("Slade")
+	assert.Nil(t, err)
+	assert.Equal(t, enumutils.SenderIDSLADE360, got)
+
+	assert.Panics(t, func() {
+		enumutils.RegisterAlias("SLADE", enumutils.SenderIDBewell)
+	}, "alias registered for a different value")
+	assert.Panics(t, func() {
+		enumutils.RegisterAlias(" ", enumutils.SenderIDBewell)
+	}, "empty alias")
+	assert.Panics(t, func() {
+		enumutils.RegisterAlias("B", enumutils.SenderID("BE WELL"))
+	}, "invalid value")
+}
+
+func TestSetLenientUnmarshal(t *testing.T) {
+	var gender enumutils.Gender
+	assert.Error(t, gender.UnmarshalGQL("Female"))
+
+	enumutils.SetLenientUnmarshal[enumutils.Gender](true)
+	t.Cleanup(func() { enumutils.SetLenientUnmarshal[enumutils.Gender](false) })
+
+	assert.Nil(t, gender.UnmarshalGQL("Female"))
+	assert.Equal(t, enumutils.GenderFemale, gender)
+
+	assert.Nil(t, json.Unmarshal([]byte(`"F"`), &gender))
+	assert.Equal(t, enumutils.GenderFemale, gender)
+
+	assert.Nil(t, gender.Scan([]byte("M")))
+	assert.Equal(t, enumutils.GenderMale, gender)
+
+	assert.True(t, errors.Is(gender.UnmarshalGQL("X"), enumutils.ErrInvalidValue))
+
+	// other enums remain strict
+	var language enumutils.Language
+	assert.Error(t, language.UnmarshalGQL("EN"))
+
+	enumutils.SetLenientUnmarshal[enumutils.Gender](false)
+	assert.Error(t, gender.UnmarshalGQL("Female"))
+}
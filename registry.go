@@ -0,0 +1,124 @@
+package enumutils
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// EnumValue describes a single value of a registered enum
+type EnumValue struct {
+	// Name is the name of the Go constant e.g GenderMale
+	Name string `json:"name"`
+
+	// Value is the string value of the constant e.g male
+	Value string `json:"value"`
+
+	// Description is taken from the doc comment of the constant
+	Description string `json:"description,omitempty"`
+
+	// Deprecated is the reason a value should no longer be used. It is empty
+	// for values that are not deprecated.
+	Deprecated string `json:"deprecated,omitempty"`
+}
+
+// EnumInfo describes a registered enum type and its values
+type EnumInfo struct {
+	// Name is the name of the Go type e.g Gender
+	Name string `json:"name"`
+
+	// Description is taken from the doc comment of the type
+	Description string `json:"description,omitempty"`
+
+	// Fallback is the value that unknown values read from the database are
+	// mapped to by Lenient. It is empty for enums without one.
+	Fallback string `json:"fallback,omitempty"`
+
+	// Values are the valid values of the enum in declaration order
+	Values []EnumValue `json:"values"`
+}
+
+// Strings returns the values of the enum as plain strings
+func (i EnumInfo) Strings() []string {
+	values := make([]string, 0, len(i.Values))
+	for _, v := range i.Values {
+		values = append(values, v.Value)
+	}
+	return values
+}
+
+// Contains returns true if the supplied string is a value of the enum
+func (i EnumInfo) Contains(value string) bool {
+	for _, v := range i.Values {
+		if v.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// copy returns a deep copy so that callers cannot modify the registry
+func (i EnumInfo) copy() EnumInfo {
+	i.Values = append([]EnumValue(nil), i.Values...)
+	return i
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]EnumInfo{}
+)
+
+// Register makes an enum available by name. The enums in this package
+// register themselves; services can register their own enums from an init
+// function.
+//
+// Register panics if the name is empty, the enum has no values or an enum
+// with the same name is already registered.
+func Register(info EnumInfo) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if info.Name == "" {
+		panic("enumutils: Register called with an empty enum name")
+	}
+	if len(info.Values) == 0 {
+		panic(fmt.Sprintf("enumutils: Register called without values for %s", info.Name))
+	}
+	if _, dup := registry[info.Name]; dup {
+		panic(fmt.Sprintf("enumutils: Register called twice for %s", info.Name))
+	}
+	registry[info.Name] = info.copy()
+}
+
+// Lookup returns the registered enum with the supplied name e.g "Gender"
+func Lookup(name string) (EnumInfo, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	info, ok := registry[name]
+	if !ok {
+		return EnumInfo{}, false
+	}
+	return info.copy(), true
+}
+
+// InfoOf returns the registry entry for the enum type T
+func InfoOf[T Enum]() (EnumInfo, bool) {
+	var zero T
+	return Lookup(zero.EnumName())
+}
+
+// Registered returns all registered enums sorted by name
+func Registered() []EnumInfo {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	infos := make([]EnumInfo, 0, len(registry))
+	for _, info := range registry {
+		infos = append(infos, info.copy())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
@@ -0,0 +1,116 @@
+package enumutils_test
+
+import (
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name       string
+		enumName   string
+		wantOK     bool
+		wantValues []string
+	}{
+		{
+			name:       "Happy case: gender",
+			enumName:   "Gender",
+			wantOK:     true,
+			wantValues: enumutils.GenderMale.Values(),
+		},
+		{
+			name:       "Happy case: content type",
+			enumName:   "ContentType",
+			wantOK:     true,
+			wantValues: []string{"PNG", "JPG", "PDF", "DOCX", "XLSX", "CSV", "HEIC", "HEIF", "WEBP", "TIFF", "DICOM"},
+		},
+		{
+			name:     "Sad case: unknown enum",
+			enumName: "Colour",
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := enumutils.Lookup(tt.enumName)
+			assert.Equal(t, tt.wantOK, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, tt.enumName, got.Name)
+			assert.Equal(t, tt.wantValues, got.Strings())
+		})
+	}
+}
+
+func TestLookup_Metadata(t *testing.T) {
+	info, ok := enumutils.Lookup("FieldType")
+	assert.True(t, ok)
+	assert.Equal(t, "FieldType is used to represent the GraphQL enum that is used for filter parameters", info.Description)
+	assert.Equal(t, enumutils.EnumValue{
+		Name:        "FieldTypeBoolean",
+		Value:       "BOOLEAN",
+		Description: "FieldTypeBoolean represents a boolean filter parameter",
+	}, info.Values[0])
+
+	// modifying a looked up enum must not modify the registry
+	info.Values[0].Value = "changed"
+	again, _ := enumutils.Lookup("FieldType")
+	assert.Equal(t, "BOOLEAN", again.Values[0].Value)
+
+	calendar, _ := enumutils.Lookup("CalendarView")
+	assert.Empty(t, calendar.Values[0].Description)
+}
+
+func TestInfoOf(t *testing.T) {
+	info, ok := enumutils.InfoOf[enumutils.PractitionerSpecialty]()
+	assert.True(t, ok)
+	assert.Equal(t, "PractitionerSpecialty", info.Name)
+	assert.True(t, info.Contains("UROLOGY"))
+	assert.False(t, info.Contains("urology"))
+	assert.Len(t, info.Values, len(enumutils.AllPractitionerSpecialty))
+}
+
+func TestRegistered(t *testing.T) {
+	names := []string{}
+	for _, info := range enumutils.Registered() {
+		names = append(names, info.Name)
+	}
+
+	for _, want := range []string{
+		"AddressType", "CalendarView", "ContentType", "FieldType", "Gender", "IdentificationDocType",
+		"Language", "Operation", "PractitionerSpecialty", "SenderID", "SortOrder",
+	} {
+		assert.Contains(t, names, want)
+	}
+	assert.IsIncreasing(t, names)
+}
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(func() { enumutils.Unregister("RegistryTestColour") })
+	enumutils.Register(enumutils.EnumInfo{
+		Name: "RegistryTestColour",
+		Values: []enumutils.EnumValue{
+			{Name: "ColourRed", Value: "RED"},
+		},
+	})
+
+	info, ok := enumutils.Lookup("RegistryTestColour")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"RED"}, info.Strings())
+
+	assert.Panics(t, func() {
+		enumutils.Register(enumutils.EnumInfo{
+			Name:   "RegistryTestColour",
+			Values: []enumutils.EnumValue{{Name: "ColourBlue", Value: "BLUE"}},
+		})
+	}, "duplicate registration")
+	assert.Panics(t, func() {
+		enumutils.Register(enumutils.EnumInfo{Values: []enumutils.EnumValue{{Name: "ColourBlue", Value: "BLUE"}}})
+	}, "empty name")
+	assert.Panics(t, func() {
+		enumutils.Register(enumutils.EnumInfo{Name: "RegistryTestShape"})
+	}, "no values")
+}
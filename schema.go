@@ -0,0 +1,103 @@
+package enumutils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ImportPath is the Go import path of this package, used when mapping the
+// GraphQL enums to their Go types
+const ImportPath = "github.com/savannahghi/enumutils"
+
+var graphQLName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// WriteGraphQLSchema writes a GraphQL SDL enum definition for each of the
+// supplied enums, e.g WriteGraphQLSchema(w, Registered()). Descriptions come
+// from the doc comments of the Go types and constants and deprecated values
+// are marked with @deprecated.
+func WriteGraphQLSchema(w io.Writer, infos []EnumInfo) error {
+	bw := bufio.NewWriter(w)
+
+	for i, info := range infos {
+		if !graphQLName.MatchString(info.Name) {
+			return fmt.Errorf("%q is not a valid GraphQL enum name", info.Name)
+		}
+
+		if i > 0 {
+			fmt.Fprintln(bw)
+		}
+		writeGraphQLDescription(bw, info.Description, "")
+		fmt.Fprintf(bw, "enum %s {\n", info.Name)
+
+		for _, value := range info.Values {
+			if !graphQLName.MatchString(value.Value) || value.Value == "true" || value.Value == "false" || value.Value == "null" {
+				return fmt.Errorf("%q is not a valid GraphQL enum value of %s", value.Value, info.Name)
+			}
+
+			writeGraphQLDescription(bw, value.Description, "  ")
+			fmt.Fprintf(bw, "  %s", value.Value)
+			if value.Deprecated != "" {
+				fmt.Fprintf(bw, " @deprecated(reason: %s)", graphQLString(value.Deprecated))
+			}
+			fmt.Fprintln(bw)
+		}
+
+		fmt.Fprintln(bw, "}")
+	}
+
+	return bw.Flush()
+}
+
+// writeGraphQLDescription writes a description as a quoted string, or as a
+// block string when it spans several lines
+func writeGraphQLDescription(w io.Writer, description, indent string) {
+	if description == "" {
+		return
+	}
+
+	if !strings.Contains(description, "\n") {
+		fmt.Fprintf(w, "%s%s\n", indent, graphQLString(description))
+		return
+	}
+
+	fmt.Fprintf(w, "%s\"\"\"\n", indent)
+	for _, line := range strings.Split(strings.ReplaceAll(description, `"""`, `\"""`), "\n") {
+		if line == "" {
+			fmt.Fprintln(w)
+			continue
+		}
+		fmt.Fprintf(w, "%s%s\n", indent, line)
+	}
+	fmt.Fprintf(w, "%s\"\"\"\n", indent)
+}
+
+// graphQLString quotes s as a GraphQL string value. JSON strings are valid
+// GraphQL strings.
+func graphQLString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // encoding a string cannot fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// WriteGqlgenModels writes the gqlgen.yml models block that maps each of the
+// supplied GraphQL enums to its Go type in the package with the supplied
+// import path, e.g WriteGqlgenModels(w, ImportPath, Registered())
+func WriteGqlgenModels(w io.Writer, importPath string, infos []EnumInfo) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "models:")
+	for _, info := range infos {
+		fmt.Fprintf(bw, "  %s:\n", info.Name)
+		fmt.Fprintln(bw, "    model:")
+		fmt.Fprintf(bw, "      - %s.%s\n", importPath, info.Name)
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,124 @@
+package enumutils_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteGraphQLSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		infos   []enumutils.EnumInfo
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Happy case: descriptions and deprecations",
+			infos: []enumutils.EnumInfo{
+				{
+					Name:        "Colour",
+					Description: "Colour is an example enum.\n\nIt has a \"\"\"quoted\"\"\" multi-line description.",
+					Values: []enumutils.EnumValue{
+						{Name: "ColourRed", Value: "RED", Description: `ColourRed is "red"`},
+						{Name: "ColourGreen", Value: "GREEN"},
+						{Name: "ColourBlu", Value: "BLU", Deprecated: `use "BLUE" instead`},
+					},
+				},
+				{
+					Name:   "Shape",
+					Values: []enumutils.EnumValue{{Name: "ShapeCircle", Value: "circle"}},
+				},
+			},
+			want: `"""
+Colour is an example enum.
+
+It has a \"""quoted\""" multi-line description.
+"""
+enum Colour {
+  "ColourRed is \"red\""
+  RED
+  GREEN
+  BLU @deprecated(reason: "use \"BLUE\" instead")
+}
+
+enum Shape {
+  circle
+}
+`,
+		},
+		{
+			name: "Happy case: registered enum",
+			infos: func() []enumutils.EnumInfo {
+				info, _ := enumutils.InfoOf[enumutils.SortOrder]()
+				return []enumutils.EnumInfo{info}
+			}(),
+			want: `"SortOrder is used to represent map sort directions to a GraphQl enum"
+enum SortOrder {
+  "SortOrderAsc is for ascending sorts"
+  ASC
+  "SortOrderDesc is for descending sorts"
+  DESC
+}
+`,
+		},
+		{
+			name: "Sad case: invalid enum name",
+			infos: []enumutils.EnumInfo{
+				{Name: "Colour-Code", Values: []enumutils.EnumValue{{Name: "Red", Value: "RED"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid value",
+			infos: []enumutils.EnumInfo{
+				{Name: "Locale", Values: []enumutils.EnumValue{{Name: "LocaleKenya", Value: "en-KE"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: reserved value",
+			infos: []enumutils.EnumInfo{
+				{Name: "Flag", Values: []enumutils.EnumValue{{Name: "FlagTrue", Value: "true"}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			err := enumutils.WriteGraphQLSchema(w, tt.infos)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, w.String())
+		})
+	}
+}
+
+func TestWriteGraphQLSchema_AllEnums(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.Nil(t, enumutils.WriteGraphQLSchema(w, enumutils.Registered()))
+	assert.Contains(t, w.String(), "enum PractitionerSpecialty {\n  UNSPECIFIED\n  ANAESTHESIA\n")
+	assert.Contains(t, w.String(), "enum Language {\n  en\n")
+}
+
+func TestWriteGqlgenModels(t *testing.T) {
+	gender, _ := enumutils.InfoOf[enumutils.Gender]()
+	senderID, _ := enumutils.InfoOf[enumutils.SenderID]()
+
+	w := &bytes.Buffer{}
+	assert.Nil(t, enumutils.WriteGqlgenModels(w, enumutils.ImportPath, []enumutils.EnumInfo{gender, senderID}))
+	assert.Equal(t, `models:
+  Gender:
+    model:
+      - github.com/savannahghi/enumutils.Gender
+  SenderID:
+    model:
+      - github.com/savannahghi/enumutils.SenderID
+`, w.String())
+}
@@ -0,0 +1,90 @@
+package enumutils
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Comparator compares two items, returning a negative number when a sorts
+// before b, a positive number when a sorts after b and zero otherwise
+type Comparator[T any] func(a, b T) int
+
+// apply reverses the result of a comparison for descending sorts. Any order
+// other than SortOrderDesc, including an empty one, sorts ascending.
+func (e SortOrder) apply(c int) int {
+	if e == SortOrderDesc {
+		return -c
+	}
+	return c
+}
+
+// SortBy sorts the items by the key in the supplied order. Items with equal
+// keys keep their original order e.g
+//
+//	enumutils.SortBy(patients, func(p Patient) string { return p.Name }, enumutils.SortOrderAsc)
+func SortBy[T any, K cmp.Ordered](items []T, key func(T) K, order SortOrder) {
+	SortStable(items, Key(key, order))
+}
+
+// SortStable sorts the items with the comparators, using each comparator to
+// break the ties of the ones before it. Items that all the comparators
+// consider equal keep their original order.
+func SortStable[T any](items []T, comparators ...Comparator[T]) {
+	slices.SortStableFunc(items, Compose(comparators...))
+}
+
+// Compose returns a comparator that compares items with each of the supplied
+// comparators in turn until one of them tells the items apart e.g sort by
+// surname and then by age, oldest first:
+//
+//	enumutils.Compose(
+//		enumutils.Key(func(p Patient) string { return p.Surname }, enumutils.SortOrderAsc),
+//		enumutils.Key(func(p Patient) int { return p.Age }, enumutils.SortOrderDesc),
+//	)
+func Compose[T any](comparators ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		for _, compare := range comparators {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// Key returns a comparator that orders items by the key in the supplied order
+func Key[T any, K cmp.Ordered](key func(T) K, order SortOrder) Comparator[T] {
+	return func(a, b T) int {
+		return order.apply(cmp.Compare(key(a), key(b)))
+	}
+}
+
+// NullableKey returns a comparator that orders items by a key that may be
+// missing, e.g an optional date of birth. Missing keys are placed first or
+// last according to nulls whatever the sort order; an empty NullsOrder places
+// them last.
+func NullableKey[T any, K cmp.Ordered](key func(T) *K, order SortOrder, nulls NullsOrder) Comparator[T] {
+	return func(a, b T) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case ka == nil && kb == nil:
+			return 0
+		case ka == nil:
+			return nulls.apply(1)
+		case kb == nil:
+			return nulls.apply(-1)
+		default:
+			return order.apply(cmp.Compare(*ka, *kb))
+		}
+	}
+}
+
+// apply places missing values last, or first for NullsOrderFirst. c is the
+// comparison of a missing value with a present one when missing values are
+// last.
+func (e NullsOrder) apply(c int) int {
+	if e == NullsOrderFirst {
+		return -c
+	}
+	return c
+}
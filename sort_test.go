@@ -0,0 +1,119 @@
+package enumutils_test
+
+import (
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type client struct {
+	name string
+	age  int
+	dob  *string
+}
+
+func names(clients []client) []string {
+	result := []string{}
+	for _, c := range clients {
+		result = append(result, c.name)
+	}
+	return result
+}
+
+func TestSortBy(t *testing.T) {
+	clients := []client{
+		{name: "Wanjiru", age: 30},
+		{name: "Akinyi", age: 25},
+		{name: "Juma", age: 30},
+		{name: "Otieno", age: 41},
+	}
+	byAge := func(c client) int { return c.age }
+
+	enumutils.SortBy(clients, byAge, enumutils.SortOrderAsc)
+	assert.Equal(t, []string{"Akinyi", "Wanjiru", "Juma", "Otieno"}, names(clients))
+
+	enumutils.SortBy(clients, byAge, enumutils.SortOrderDesc)
+	assert.Equal(t, []string{"Otieno", "Wanjiru", "Juma", "Akinyi"}, names(clients))
+
+	enumutils.SortBy(clients, func(c client) string { return c.name }, "")
+	assert.Equal(t, []string{"Akinyi", "Juma", "Otieno", "Wanjiru"}, names(clients))
+}
+
+func TestSortStable(t *testing.T) {
+	clients := []client{
+		{name: "Wanjiru", age: 30},
+		{name: "Akinyi", age: 25},
+		{name: "Juma", age: 30},
+		{name: "Otieno", age: 41},
+	}
+
+	enumutils.SortStable(clients,
+		enumutils.Key(func(c client) int { return c.age }, enumutils.SortOrderDesc),
+		enumutils.Key(func(c client) string { return c.name }, enumutils.SortOrderAsc),
+	)
+	assert.Equal(t, []string{"Otieno", "Juma", "Wanjiru", "Akinyi"}, names(clients))
+
+	// with no comparators every item is equal and the order is unchanged
+	enumutils.SortStable(clients)
+	assert.Equal(t, []string{"Otieno", "Juma", "Wanjiru", "Akinyi"}, names(clients))
+}
+
+func TestNullableKey(t *testing.T) {
+	early, late := "1990-01-01", "2001-06-30"
+	byDOB := func(c client) *string { return c.dob }
+
+	tests := []struct {
+		name  string
+		order enumutils.SortOrder
+		nulls enumutils.NullsOrder
+		want  []string
+	}{
+		{
+			name:  "ascending nulls last",
+			order: enumutils.SortOrderAsc,
+			nulls: enumutils.NullsOrderLast,
+			want:  []string{"Akinyi", "Juma", "Wanjiru", "Otieno"},
+		},
+		{
+			name:  "descending nulls last",
+			order: enumutils.SortOrderDesc,
+			nulls: enumutils.NullsOrderLast,
+			want:  []string{"Juma", "Akinyi", "Wanjiru", "Otieno"},
+		},
+		{
+			name:  "ascending nulls first",
+			order: enumutils.SortOrderAsc,
+			nulls: enumutils.NullsOrderFirst,
+			want:  []string{"Wanjiru", "Otieno", "Akinyi", "Juma"},
+		},
+		{
+			name:  "descending with the default nulls order",
+			order: enumutils.SortOrderDesc,
+			want:  []string{"Juma", "Akinyi", "Wanjiru", "Otieno"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := []client{
+				{name: "Wanjiru"},
+				{name: "Akinyi", dob: &early},
+				{name: "Otieno"},
+				{name: "Juma", dob: &late},
+			}
+			enumutils.SortStable(clients, enumutils.NullableKey(byDOB, tt.order, tt.nulls))
+			assert.Equal(t, tt.want, names(clients))
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	compare := enumutils.Compose(
+		enumutils.Key(func(c client) int { return c.age }, enumutils.SortOrderAsc),
+		enumutils.Key(func(c client) string { return c.name }, enumutils.SortOrderDesc),
+	)
+
+	assert.Negative(t, compare(client{age: 1}, client{age: 2}))
+	assert.Negative(t, compare(client{name: "b"}, client{name: "a"}))
+	assert.Zero(t, compare(client{name: "a", age: 1}, client{name: "a", age: 1}))
+}
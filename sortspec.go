@@ -0,0 +1,149 @@
+package enumutils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortParam is a field to sort by and the direction to sort it in
+type SortParam struct {
+	FieldName string    `json:"fieldName"`
+	SortOrder SortOrder `json:"sortOrder"`
+}
+
+// SortInput is the GraphQL input used to sort list queries
+type SortInput struct {
+	SortBy []*SortParam `json:"sortBy"`
+}
+
+// SortSpec is an ordered list of fields to sort by, the most significant
+// field first
+type SortSpec []SortParam
+
+// ParseSortSpec parses a sort query parameter into a SortSpec. Fields are
+// separated by commas and each is written as "field:order" e.g
+// "name:asc,createdAt:desc", or as the field name prefixed with "-" for
+// descending and optionally "+" for ascending e.g "-createdAt,name". A field
+// without an order is sorted ascending and orders are parsed with
+// Normalize[SortOrder], so "asc" and "DESCENDING" are accepted.
+//
+// Only the allowed fields can be sorted by and each can only appear once. An
+// empty string is an empty SortSpec.
+func ParseSortSpec(s string, allowed ...string) (SortSpec, error) {
+	spec := SortSpec{}
+	if strings.TrimSpace(s) == "" {
+		return spec, nil
+	}
+
+	for i, item := range strings.Split(s, ",") {
+		param, err := parseSortParam(strings.TrimSpace(item))
+		if err != nil {
+			return nil, fmt.Errorf("sort[%d]: %w", i, err)
+		}
+		spec = append(spec, param)
+	}
+
+	if err := spec.Validate(allowed...); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func parseSortParam(item string) (SortParam, error) {
+	param := SortParam{FieldName: item, SortOrder: SortOrderAsc}
+
+	switch {
+	case strings.HasPrefix(item, "-"):
+		param.FieldName, param.SortOrder = item[1:], SortOrderDesc
+	case strings.HasPrefix(item, "+"):
+		param.FieldName = item[1:]
+	}
+
+	if field, order, ok := strings.Cut(param.FieldName, ":"); ok {
+		if param.FieldName != item {
+			return SortParam{}, fmt.Errorf("%q has both a sign and an order", item)
+		}
+		sortOrder, err := Normalize[SortOrder](order)
+		if err != nil {
+			return SortParam{}, err
+		}
+		param.FieldName, param.SortOrder = strings.TrimSpace(field), sortOrder
+	}
+
+	if param.FieldName == "" {
+		return SortParam{}, fmt.Errorf("%q has no field name", item)
+	}
+	return param, nil
+}
+
+// Validate checks that every field is allowed, appears once and has a valid
+// sort order
+func (s SortSpec) Validate(allowed ...string) error {
+	seen := map[string]bool{}
+	for i, param := range s {
+		if !param.SortOrder.IsValid() {
+			return fmt.Errorf("sort[%d]: %w", i, newInvalidEnumError(param.SortOrder.EnumName(), param.SortOrder.String(), param.SortOrder.Values()))
+		}
+		if !slices.Contains(allowed, param.FieldName) {
+			return fmt.Errorf("sort[%d]: %w %q", i, ErrUnknownField, param.FieldName)
+		}
+		if seen[param.FieldName] {
+			return fmt.Errorf("sort[%d]: %s is sorted by more than once", i, param.FieldName)
+		}
+		seen[param.FieldName] = true
+	}
+	return nil
+}
+
+// String returns the spec in the format accepted by ParseSortSpec e.g
+// "name:ASC,createdAt:DESC"
+func (s SortSpec) String() string {
+	items := make([]string, 0, len(s))
+	for _, param := range s {
+		items = append(items, param.FieldName+":"+param.SortOrder.String())
+	}
+	return strings.Join(items, ",")
+}
+
+// OrderBy returns the spec as the expressions of a SQL ORDER BY clause e.g
+// "name ASC, p.created_at DESC". Columns maps each field name to its column
+// and doubles as an allowlist; an empty spec returns an empty string.
+func (s SortSpec) OrderBy(columns map[string]string) (string, error) {
+	items := make([]string, 0, len(s))
+	for i, param := range s {
+		column, ok := columns[param.FieldName]
+		if !ok {
+			return "", fmt.Errorf("sort[%d]: %w %q", i, ErrUnknownField, param.FieldName)
+		}
+		if !sqlColumn.MatchString(column) {
+			return "", fmt.Errorf("%q is not a valid column name for %s", column, param.FieldName)
+		}
+		if !param.SortOrder.IsValid() {
+			return "", fmt.Errorf("sort[%d]: %w", i, newInvalidEnumError(param.SortOrder.EnumName(), param.SortOrder.String(), param.SortOrder.Values()))
+		}
+		items = append(items, column+" "+param.SortOrder.String())
+	}
+	return strings.Join(items, ", "), nil
+}
+
+// SortInput returns the spec as a GraphQL SortInput
+func (s SortSpec) SortInput() SortInput {
+	input := SortInput{SortBy: make([]*SortParam, 0, len(s))}
+	for _, param := range s {
+		input.SortBy = append(input.SortBy, &param)
+	}
+	return input
+}
+
+// SortSpec returns the fields of a GraphQL SortInput as a SortSpec, skipping
+// nil entries
+func (i SortInput) SortSpec() SortSpec {
+	spec := SortSpec{}
+	for _, param := range i.SortBy {
+		if param != nil {
+			spec = append(spec, *param)
+		}
+	}
+	return spec
+}
@@ -0,0 +1,143 @@
+package enumutils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSortSpec(t *testing.T) {
+	allowed := []string{"name", "createdAt", "age"}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    enumutils.SortSpec
+		wantErr string
+	}{
+		{
+			name:  "field and order",
+			input: "name:asc,createdAt:desc",
+			want: enumutils.SortSpec{
+				{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+				{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+			},
+		},
+		{
+			name:  "signs",
+			input: "-createdAt, name,+age",
+			want: enumutils.SortSpec{
+				{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+				{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+				{FieldName: "age", SortOrder: enumutils.SortOrderAsc},
+			},
+		},
+		{
+			name:  "order alias",
+			input: "age:DESCENDING",
+			want:  enumutils.SortSpec{{FieldName: "age", SortOrder: enumutils.SortOrderDesc}},
+		},
+		{
+			name:  "empty",
+			input: " ",
+			want:  enumutils.SortSpec{},
+		},
+		{
+			name:    "invalid order",
+			input:   "name,age:up",
+			wantErr: "sort[1]: up is not a valid SortOrder",
+		},
+		{
+			name:    "sign and order",
+			input:   "-age:asc",
+			wantErr: `sort[0]: "-age:asc" has both a sign and an order`,
+		},
+		{
+			name:    "missing field",
+			input:   "name,",
+			wantErr: `sort[1]: "" has no field name`,
+		},
+		{
+			name:    "field not allowed",
+			input:   "name,password",
+			wantErr: `sort[1]: unknown field "password"`,
+		},
+		{
+			name:    "duplicate field",
+			input:   "name,-name",
+			wantErr: "sort[1]: name is sorted by more than once",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumutils.ParseSortSpec(tt.input, allowed...)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSortSpec_String(t *testing.T) {
+	spec, err := enumutils.ParseSortSpec("-createdAt,name", "name", "createdAt")
+	assert.NoError(t, err)
+	assert.Equal(t, "createdAt:DESC,name:ASC", spec.String())
+
+	parsed, err := enumutils.ParseSortSpec(spec.String(), "name", "createdAt")
+	assert.NoError(t, err)
+	assert.Equal(t, spec, parsed)
+}
+
+func TestSortSpec_OrderBy(t *testing.T) {
+	columns := map[string]string{"name": "p.name", "createdAt": "created_at", "bad": "1; DROP TABLE patient"}
+
+	orderBy, err := enumutils.SortSpec{
+		{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+		{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+	}.OrderBy(columns)
+	assert.NoError(t, err)
+	assert.Equal(t, "created_at DESC, p.name ASC", orderBy)
+
+	orderBy, err = enumutils.SortSpec{}.OrderBy(columns)
+	assert.NoError(t, err)
+	assert.Equal(t, "", orderBy)
+
+	_, err = enumutils.SortSpec{{FieldName: "age", SortOrder: enumutils.SortOrderAsc}}.OrderBy(columns)
+	assert.True(t, errors.Is(err, enumutils.ErrUnknownField))
+
+	_, err = enumutils.SortSpec{{FieldName: "bad", SortOrder: enumutils.SortOrderAsc}}.OrderBy(columns)
+	assert.Error(t, err)
+
+	_, err = enumutils.SortSpec{{FieldName: "name", SortOrder: "ASC; DROP TABLE patient"}}.OrderBy(columns)
+	assert.True(t, errors.Is(err, enumutils.ErrInvalidValue))
+}
+
+func TestSortSpec_Validate(t *testing.T) {
+	err := enumutils.SortSpec{{FieldName: "name", SortOrder: "UP"}}.Validate("name")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "sort[0]: UP is not a valid SortOrder")
+	}
+}
+
+func TestSortInput(t *testing.T) {
+	spec := enumutils.SortSpec{
+		{FieldName: "createdAt", SortOrder: enumutils.SortOrderDesc},
+		{FieldName: "name", SortOrder: enumutils.SortOrderAsc},
+	}
+
+	input := spec.SortInput()
+	if assert.Len(t, input.SortBy, 2) {
+		assert.Equal(t, "createdAt", input.SortBy[0].FieldName)
+		assert.Equal(t, enumutils.SortOrderAsc, input.SortBy[1].SortOrder)
+	}
+
+	input.SortBy = append(input.SortBy, nil)
+	assert.Equal(t, spec, input.SortSpec())
+}
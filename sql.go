@@ -0,0 +1,82 @@
+package enumutils
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Lenient scans a database column of the enum T leniently. Input that
+// Normalize accepts is normalised and any other value is replaced by the
+// enum's fallback value, e.g GenderUnknown or PractitionerSpecialtyUnspecified,
+// instead of being an error. Enums without a fallback still reject unknown
+// values and NULL is still an error.
+//
+// Leniency is opted into per column, e.g for a column that may hold legacy
+// values while a data migration is in progress, and does not change how the
+// enum is scanned anywhere else:
+//
+//	var gender enumutils.Lenient[enumutils.Gender]
+//	err := db.QueryRowContext(ctx, "SELECT gender FROM patient WHERE id = $1", id).Scan(&gender)
+//	patient.Gender = gender.V
+type Lenient[T Enum] struct {
+	V T
+}
+
+// Scan converts a value read from the database into a value of T, using the
+// fallback value of T for unknown values
+func (l *Lenient[T]) Scan(src interface{}) error {
+	var zero T
+	str, err := scanString(zero.EnumName(), src)
+	if err != nil {
+		return err
+	}
+
+	value, err := Normalize[T](str)
+	if err != nil {
+		info, ok := InfoOf[T]()
+		if !ok || info.Fallback == "" {
+			return err
+		}
+		value = T(info.Fallback)
+	}
+	l.V = value
+	return nil
+}
+
+// Value validates the enum before it is written to the database
+func (l Lenient[T]) Value() (driver.Value, error) {
+	return driverValue(l.V)
+}
+
+// scanEnum converts a value read from the database, if valid, into an enum
+func scanEnum[T Enum, P EnumPointer[T]](e P, src interface{}) error {
+	var zero T
+	str, err := scanString(zero.EnumName(), src)
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(str)
+}
+
+// scanString returns the text of a value read from the database
+func scanString(enumName string, src interface{}) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	case nil:
+		return "", fmt.Errorf("%w: cannot scan NULL into %s, use sql.Null[%s] for nullable columns", ErrNull, enumName, enumName)
+	default:
+		return "", fmt.Errorf("%w: cannot scan %T into %s", ErrNotString, src, enumName)
+	}
+}
+
+// driverValue returns the enum as a string, rejecting invalid values so that
+// they are never written to the database
+func driverValue[T Enum](e T) (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, newInvalidEnumError(e.EnumName(), e.String(), e.Values())
+	}
+	return e.String(), nil
+}
@@ -0,0 +1,209 @@
+package enumutils_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver is a minimal database/sql driver. Queries return a single row
+// whose only column holds the value registered for the query text and Exec
+// records the arguments it receives.
+type fakeDriver struct {
+	mu       sync.Mutex
+	rows     map[string]driver.Value
+	execArgs []driver.Value
+}
+
+var fakeDB = &fakeDriver{
+	rows: map[string]driver.Value{
+		"string":  "female",
+		"bytes":   []byte("PASSPORT"),
+		"unknown": "MALE",
+		"null":    nil,
+		"integer": int64(1),
+	},
+}
+
+func init() {
+	sql.Register("enumutils-fake", fakeDB)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{driver: d}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{driver: c.driver, query: query}, nil
+}
+
+func (c fakeConn) Close() error {
+	return nil
+}
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	driver *fakeDriver
+	query  string
+}
+
+func (s fakeStmt) Close() error {
+	return nil
+}
+
+func (s fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+
+	s.driver.execArgs = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	value, ok := s.driver.rows[s.query]
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+	return &fakeRows{value: value}, nil
+}
+
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("enumutils-fake", "")
+	if err != nil {
+		t.Fatalf("unable to open fake database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestScan(t *testing.T) {
+	db := openFakeDB(t)
+
+	var gender enumutils.Gender
+	assert.Nil(t, db.QueryRow("string").Scan(&gender))
+	assert.Equal(t, enumutils.GenderFemale, gender)
+
+	var docType enumutils.IdentificationDocType
+	assert.Nil(t, db.QueryRow("bytes").Scan(&docType))
+	assert.Equal(t, enumutils.IdentificationDocTypePassport, docType)
+
+	err := db.QueryRow("unknown").Scan(&gender)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "MALE is not a valid Gender")
+	}
+
+	err = db.QueryRow("null").Scan(&gender)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "cannot scan NULL into Gender")
+		assert.True(t, errors.Is(err, enumutils.ErrNull))
+		assert.False(t, errors.Is(err, enumutils.ErrNotString))
+	}
+
+	var nullable sql.Null[enumutils.Gender]
+	assert.Nil(t, db.QueryRow("null").Scan(&nullable))
+	assert.False(t, nullable.Valid)
+	assert.Nil(t, db.QueryRow("string").Scan(&nullable))
+	assert.Equal(t, sql.Null[enumutils.Gender]{V: enumutils.GenderFemale, Valid: true}, nullable)
+
+	var addressType enumutils.AddressType
+	err = db.QueryRow("integer").Scan(&addressType)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "cannot scan int64 into AddressType")
+		assert.True(t, errors.Is(err, enumutils.ErrNotString))
+	}
+}
+
+func TestScan_Lenient(t *testing.T) {
+	db := openFakeDB(t)
+
+	var gender enumutils.Lenient[enumutils.Gender]
+	assert.Nil(t, db.QueryRow("unknown").Scan(&gender))
+	assert.Equal(t, enumutils.GenderMale, gender.V, "normalised")
+	assert.Nil(t, gender.Scan("robot"))
+	assert.Equal(t, enumutils.GenderUnknown, gender.V)
+
+	var specialty enumutils.Lenient[enumutils.PractitionerSpecialty]
+	assert.Nil(t, specialty.Scan([]byte("CLINICAL_PATHOLOGY")))
+	assert.Equal(t, enumutils.PractitionerSpecialtyUnspecified, specialty.V)
+
+	// other columns of the same enum remain strict
+	var strict enumutils.Gender
+	assert.Error(t, db.QueryRow("unknown").Scan(&strict))
+
+	// enums without a fallback value remain strict
+	var addressType enumutils.Lenient[enumutils.AddressType]
+	assert.True(t, errors.Is(addressType.Scan("OFFICE"), enumutils.ErrInvalidValue))
+
+	// NULL is still rejected unless the column is scanned into sql.Null
+	assert.True(t, errors.Is(db.QueryRow("null").Scan(&gender), enumutils.ErrNull))
+	var nullable sql.Null[enumutils.Lenient[enumutils.Gender]]
+	assert.Nil(t, db.QueryRow("null").Scan(&nullable))
+	assert.False(t, nullable.Valid)
+	assert.Nil(t, db.QueryRow("unknown").Scan(&nullable))
+	assert.Equal(t, enumutils.GenderMale, nullable.V.V)
+
+	_, err := db.Exec("insert", enumutils.Lenient[enumutils.Gender]{V: enumutils.GenderFemale})
+	assert.Nil(t, err)
+	assert.Equal(t, []driver.Value{"female"}, fakeDB.execArgs)
+	_, err = db.Exec("insert", enumutils.Lenient[enumutils.Gender]{})
+	assert.Error(t, err)
+}
+
+func TestValue(t *testing.T) {
+	db := openFakeDB(t)
+
+	_, err := db.Exec("insert", enumutils.GenderMale, enumutils.AddressTypeHome, enumutils.PractitionerSpecialtyUrology)
+	assert.Nil(t, err)
+	assert.Equal(t, []driver.Value{"male", "HOME", "UROLOGY"}, fakeDB.execArgs)
+
+	_, err = db.Exec("insert", enumutils.Gender("MALE"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "MALE is not a valid Gender")
+	}
+
+	var nilGender *enumutils.Gender
+	_, err = db.Exec("insert", nilGender)
+	assert.Nil(t, err)
+	assert.Equal(t, []driver.Value{nil}, fakeDB.execArgs)
+}
@@ -0,0 +1,177 @@
+package enumutils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrUnknownField is returned when a filter or sort names a field that has not
+// been allowed by a SQLFilterBuilder or SortSpec
+var ErrUnknownField = errors.New("unknown field")
+
+// sqlColumn matches plain and table qualified column names e.g created_at or
+// p.created_at
+var sqlColumn = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// sqlOperators are the SQL comparison operators of the operations that
+// compare a single value
+var sqlOperators = map[Operation]string{
+	OperationLessThan:             "<",
+	OperationLessThanOrEqualTo:    "<=",
+	OperationEqual:                "=",
+	OperationGreaterThan:          ">",
+	OperationGreaterThanOrEqualTo: ">=",
+}
+
+// likeEscaper escapes the LIKE wildcards, and the escape character itself, so
+// that CONTAINS matches them literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SQLFilterBuilder turns filters into parameterised Postgres WHERE conditions
+// e.g "age > $1" with the argument 18. Values are never written into the SQL;
+// they are converted to the field type and returned as arguments.
+//
+// Only the fields in Columns can be filtered on, so that clients cannot refer
+// to arbitrary columns:
+//
+//	builder := enumutils.SQLFilterBuilder{
+//		Columns:   map[string]string{"age": "age", "createdAt": "p.created_at"},
+//		ArgOffset: 1,
+//	}
+//	where, args, err := builder.FilterSet(filters)
+//	query := "SELECT * FROM patient p WHERE p.tenant_id = $1 AND " + where
+//	rows, err := db.QueryContext(ctx, query, append([]interface{}{tenantID}, args...)...)
+type SQLFilterBuilder struct {
+	// Columns maps the field names used in filters to column names
+	Columns map[string]string
+
+	// ArgOffset is the number of arguments that precede the condition in
+	// the query. The first placeholder is $ArgOffset+1.
+	ArgOffset int
+}
+
+// Condition returns the condition and arguments of a filter on the column
+// allowed for the field e.g Condition("county", FieldTypeString,
+// OperationIn, []string{"Nairobi", "Mombasa"}) returns "county IN ($1, $2)".
+// CONTAINS is a case-insensitive ILIKE and an empty IN list is FALSE.
+func (b SQLFilterBuilder) Condition(field string, fieldType FieldType, operation Operation, value interface{}) (string, []interface{}, error) {
+	w := sqlWriter{offset: b.ArgOffset}
+	if err := b.writeCondition(&w, field, fieldType, operation, value); err != nil {
+		return "", nil, err
+	}
+	return w.sql.String(), w.args, nil
+}
+
+// Filter returns the condition and arguments of a filter. The condition is a
+// single term, so it can be appended to other conditions with AND.
+func (b SQLFilterBuilder) Filter(filter FilterParam) (string, []interface{}, error) {
+	if err := filter.Validate(); err != nil {
+		return "", nil, err
+	}
+	return b.Condition(filter.FieldName, filter.FieldType, filter.ComparisonOperation, filter.FieldValue)
+}
+
+// FilterSet returns the condition and arguments of a filter set. A set or
+// group of more than one condition is enclosed in parentheses, so the result
+// can be appended to other conditions with AND, and an empty set is TRUE.
+func (b SQLFilterBuilder) FilterSet(set FilterSet) (string, []interface{}, error) {
+	if err := set.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	w := sqlWriter{offset: b.ArgOffset}
+	if err := b.writeSet(&w, set); err != nil {
+		return "", nil, err
+	}
+	return w.sql.String(), w.args, nil
+}
+
+func (b SQLFilterBuilder) writeSet(w *sqlWriter, set FilterSet) error {
+	if len(set.Filters) == 0 && len(set.Groups) == 0 {
+		w.sql.WriteString("TRUE")
+		return nil
+	}
+
+	separator := " AND "
+	if set.Logic == FilterLogicOr {
+		separator = " OR "
+	}
+
+	// a set of several conditions is parenthesised so that it can be combined
+	// with other conditions without its OR binding to them
+	if len(set.Filters)+len(set.Groups) > 1 {
+		w.sql.WriteString("(")
+		defer w.sql.WriteString(")")
+	}
+
+	for i, filter := range set.Filters {
+		if i > 0 {
+			w.sql.WriteString(separator)
+		}
+		err := b.writeCondition(w, filter.FieldName, filter.FieldType, filter.ComparisonOperation, filter.FieldValue)
+		if err != nil {
+			return fmt.Errorf("filters[%d]: %w", i, err)
+		}
+	}
+	for i, group := range set.Groups {
+		if i > 0 || len(set.Filters) > 0 {
+			w.sql.WriteString(separator)
+		}
+		if err := b.writeSet(w, group); err != nil {
+			return fmt.Errorf("groups[%d].%w", i, err)
+		}
+	}
+	return nil
+}
+
+func (b SQLFilterBuilder) writeCondition(w *sqlWriter, field string, fieldType FieldType, operation Operation, value interface{}) error {
+	column, ok := b.Columns[field]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownField, field)
+	}
+	if !sqlColumn.MatchString(column) {
+		return fmt.Errorf("%q is not a valid column name for %s", column, field)
+	}
+
+	if err := ValidateFilter(fieldType, operation); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	values, err := coerceFilterValue(fieldType, operation, value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+
+	switch operation {
+	case OperationIn:
+		if len(values) == 0 {
+			w.sql.WriteString("FALSE")
+			return nil
+		}
+		placeholders := make([]string, 0, len(values))
+		for _, v := range values {
+			placeholders = append(placeholders, w.arg(v))
+		}
+		fmt.Fprintf(&w.sql, "%s IN (%s)", column, strings.Join(placeholders, ", "))
+	case OperationContains:
+		pattern := "%" + likeEscaper.Replace(values[0].(string)) + "%"
+		fmt.Fprintf(&w.sql, `%s ILIKE %s ESCAPE '\'`, column, w.arg(pattern))
+	default:
+		fmt.Fprintf(&w.sql, "%s %s %s", column, sqlOperators[operation], w.arg(values[0]))
+	}
+	return nil
+}
+
+// sqlWriter accumulates a condition and its arguments, numbering the
+// placeholders in the order the arguments are added
+type sqlWriter struct {
+	sql    strings.Builder
+	args   []interface{}
+	offset int
+}
+
+func (w *sqlWriter) arg(v interface{}) string {
+	w.args = append(w.args, v)
+	return fmt.Sprintf("$%d", w.offset+len(w.args))
+}
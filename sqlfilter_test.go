@@ -0,0 +1,257 @@
+package enumutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLFilterBuilder_Condition(t *testing.T) {
+	builder := enumutils.SQLFilterBuilder{
+		Columns: map[string]string{
+			"age":       "age",
+			"active":    "active",
+			"name":      "p.name",
+			"createdAt": "p.created_at",
+			"bad":       "name; DROP TABLE patient",
+		},
+		ArgOffset: 2,
+	}
+
+	tests := []struct {
+		name      string
+		field     string
+		fieldType enumutils.FieldType
+		operation enumutils.Operation
+		value     interface{}
+		wantSQL   string
+		wantArgs  []interface{}
+		wantErr   string
+	}{
+		{
+			name:      "comparison",
+			field:     "age",
+			fieldType: enumutils.FieldTypeInteger,
+			operation: enumutils.OperationGreaterThanOrEqualTo,
+			value:     json.Number("18"),
+			wantSQL:   "age >= $3",
+			wantArgs:  []interface{}{int64(18)},
+		},
+		{
+			name:      "timestamp",
+			field:     "createdAt",
+			fieldType: enumutils.FieldTypeTimestamp,
+			operation: enumutils.OperationLessThan,
+			value:     "2024-03-01",
+			wantSQL:   "p.created_at < $3",
+			wantArgs:  []interface{}{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:      "in",
+			field:     "active",
+			fieldType: enumutils.FieldTypeBoolean,
+			operation: enumutils.OperationIn,
+			value:     []string{"true", "false"},
+			wantSQL:   "active IN ($3, $4)",
+			wantArgs:  []interface{}{true, false},
+		},
+		{
+			name:      "empty in",
+			field:     "age",
+			fieldType: enumutils.FieldTypeInteger,
+			operation: enumutils.OperationIn,
+			value:     []int{},
+			wantSQL:   "FALSE",
+		},
+		{
+			name:      "contains escapes wildcards",
+			field:     "name",
+			fieldType: enumutils.FieldTypeString,
+			operation: enumutils.OperationContains,
+			value:     `50%_off\`,
+			wantSQL:   `p.name ILIKE $3 ESCAPE '\'`,
+			wantArgs:  []interface{}{`%50\%\_off\\%`},
+		},
+		{
+			name:      "string equal is not escaped",
+			field:     "name",
+			fieldType: enumutils.FieldTypeString,
+			operation: enumutils.OperationEqual,
+			value:     "a'b%",
+			wantSQL:   "p.name = $3",
+			wantArgs:  []interface{}{"a'b%"},
+		},
+		{
+			name:      "unknown field",
+			field:     "password",
+			fieldType: enumutils.FieldTypeString,
+			operation: enumutils.OperationEqual,
+			value:     "x",
+			wantErr:   `unknown field "password"`,
+		},
+		{
+			name:      "invalid column",
+			field:     "bad",
+			fieldType: enumutils.FieldTypeString,
+			operation: enumutils.OperationEqual,
+			value:     "x",
+			wantErr:   "is not a valid column name for bad",
+		},
+		{
+			name:      "unsupported operation",
+			field:     "active",
+			fieldType: enumutils.FieldTypeBoolean,
+			operation: enumutils.OperationLessThan,
+			value:     true,
+			wantErr:   "active: LESS_THAN is not supported for BOOLEAN filters",
+		},
+		{
+			name:      "invalid value",
+			field:     "age",
+			fieldType: enumutils.FieldTypeInteger,
+			operation: enumutils.OperationEqual,
+			value:     "1; --",
+			wantErr:   "age: invalid INTEGER filter value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := builder.Condition(tt.field, tt.fieldType, tt.operation, tt.value)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestSQLFilterBuilder_Filter(t *testing.T) {
+	builder := enumutils.SQLFilterBuilder{Columns: map[string]string{"age": "age"}}
+
+	sql, args, err := builder.Filter(enumutils.FilterParam{
+		FieldName:           "age",
+		FieldType:           enumutils.FieldTypeNumber,
+		ComparisonOperation: enumutils.OperationEqual,
+		FieldValue:          2.5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "age = $1", sql)
+	assert.Equal(t, []interface{}{2.5}, args)
+
+	_, _, err = builder.Filter(enumutils.FilterParam{FieldType: enumutils.FieldTypeNumber})
+	assert.Error(t, err)
+}
+
+func TestSQLFilterBuilder_FilterSet(t *testing.T) {
+	builder := enumutils.SQLFilterBuilder{
+		Columns: map[string]string{"age": "age", "county": "county", "active": "active"},
+	}
+
+	var set enumutils.FilterSet
+	err := json.Unmarshal([]byte(`{
+		"filters": [
+			{"fieldName": "age", "fieldType": "INTEGER", "comparisonOperation": "GREATER_THAN", "fieldValue": 18},
+			{"fieldName": "active", "fieldType": "BOOLEAN", "comparisonOperation": "EQUAL", "fieldValue": true}
+		],
+		"groups": [
+			{
+				"logic": "OR",
+				"filters": [
+					{"fieldName": "county", "fieldType": "STRING", "comparisonOperation": "EQUAL", "fieldValue": "Nairobi"}
+				],
+				"groups": [{"filters": [
+					{"fieldName": "county", "fieldType": "STRING", "comparisonOperation": "IN", "fieldValue": ["Kisumu", "Mombasa"]}
+				]}]
+			}
+		]
+	}`), &set)
+	assert.NoError(t, err)
+
+	sql, args, err := builder.FilterSet(set)
+	assert.NoError(t, err)
+	assert.Equal(t, "(age > $1 AND active = $2 AND (county = $3 OR county IN ($4, $5)))", sql)
+	assert.Equal(t, []interface{}{int64(18), true, "Nairobi", "Kisumu", "Mombasa"}, args)
+
+	sql, args, err = builder.FilterSet(enumutils.FilterSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, "TRUE", sql)
+	assert.Empty(t, args)
+
+	set = enumutils.FilterSet{Groups: []enumutils.FilterSet{{}, {Filters: []enumutils.FilterParam{{
+		FieldName:           "name",
+		FieldType:           enumutils.FieldTypeString,
+		ComparisonOperation: enumutils.OperationEqual,
+		FieldValue:          "Juma",
+	}}}}}
+	_, _, err = builder.FilterSet(set)
+	assert.True(t, errors.Is(err, enumutils.ErrUnknownField))
+	if assert.Error(t, err) {
+		assert.Equal(t, `groups[1].filters[0]: unknown field "name"`, err.Error())
+	}
+
+	_, _, err = builder.FilterSet(enumutils.FilterSet{Logic: "NOR"})
+	assert.Error(t, err)
+}
+
+func TestSQLFilterBuilder_FilterSetAfterCondition(t *testing.T) {
+	builder := enumutils.SQLFilterBuilder{
+		Columns:   map[string]string{"county": "county", "active": "active"},
+		ArgOffset: 1,
+	}
+	county := enumutils.FilterParam{
+		FieldName:           "county",
+		FieldType:           enumutils.FieldTypeString,
+		ComparisonOperation: enumutils.OperationEqual,
+		FieldValue:          "Nairobi",
+	}
+	active := enumutils.FilterParam{
+		FieldName:           "active",
+		FieldType:           enumutils.FieldTypeBoolean,
+		ComparisonOperation: enumutils.OperationEqual,
+		FieldValue:          true,
+	}
+
+	tests := []struct {
+		name    string
+		set     enumutils.FilterSet
+		wantSQL string
+	}{
+		{
+			name:    "OR set",
+			set:     enumutils.FilterSet{Logic: enumutils.FilterLogicOr, Filters: []enumutils.FilterParam{county, active}},
+			wantSQL: "tenant_id = $1 AND (county = $2 OR active = $3)",
+		},
+		{
+			name: "OR set of a filter and a group",
+			set: enumutils.FilterSet{
+				Logic:   enumutils.FilterLogicOr,
+				Filters: []enumutils.FilterParam{county},
+				Groups:  []enumutils.FilterSet{{Filters: []enumutils.FilterParam{active}}},
+			},
+			wantSQL: "tenant_id = $1 AND (county = $2 OR active = $3)",
+		},
+		{
+			name:    "single filter",
+			set:     enumutils.FilterSet{Logic: enumutils.FilterLogicOr, Filters: []enumutils.FilterParam{county}},
+			wantSQL: "tenant_id = $1 AND county = $2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args, err := builder.FilterSet(tt.set)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, "tenant_id = $1 AND "+where)
+			assert.Len(t, args, strings.Count(where, "$"))
+		})
+	}
+}
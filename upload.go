@@ -0,0 +1,202 @@
+package enumutils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrFileTooLarge is returned when an upload is larger than its policy
+	// allows
+	ErrFileTooLarge = errors.New("file too large")
+
+	// ErrContentTypeNotAllowed is returned when an upload policy does not
+	// allow a content type, or does not allow it for a purpose
+	ErrContentTypeNotAllowed = errors.New("content type not allowed")
+)
+
+// FileTooLargeError is returned when an upload is larger than the maximum
+// size of its content type
+type FileTooLargeError struct {
+	ContentType ContentType
+	MaxBytes    int64
+}
+
+func (e *FileTooLargeError) Error() string {
+	return fmt.Sprintf("%s files must not be larger than %d bytes", e.ContentType, e.MaxBytes)
+}
+
+// Unwrap returns ErrFileTooLarge
+func (e *FileTooLargeError) Unwrap() error {
+	return ErrFileTooLarge
+}
+
+// ContentTypeNotAllowedError is returned when a content type cannot be
+// uploaded for a purpose e.g a DICOM image as an avatar
+type ContentTypeNotAllowedError struct {
+	ContentType ContentType
+	Purpose     UploadPurpose
+
+	// Allowed are the content types that can be uploaded for the purpose
+	Allowed []ContentType
+}
+
+func (e *ContentTypeNotAllowedError) Error() string {
+	allowed := make([]string, 0, len(e.Allowed))
+	for _, contentType := range e.Allowed {
+		allowed = append(allowed, contentType.String())
+	}
+	if len(allowed) == 0 {
+		return fmt.Sprintf("%s files are not allowed for %s uploads", e.ContentType, e.Purpose)
+	}
+	return fmt.Sprintf("%s files are not allowed for %s uploads, use one of %s", e.ContentType, e.Purpose, strings.Join(allowed, ", "))
+}
+
+// Unwrap returns ErrContentTypeNotAllowed
+func (e *ContentTypeNotAllowedError) Unwrap() error {
+	return ErrContentTypeNotAllowed
+}
+
+// UploadRule limits the uploads of a content type
+type UploadRule struct {
+	// MaxBytes is the largest allowed size. Zero means there is no limit.
+	MaxBytes int64
+
+	// Purposes are the purposes the content type can be uploaded for. An
+	// empty list allows every purpose.
+	Purposes []UploadPurpose
+}
+
+// UploadPolicy is the rule for each content type that can be uploaded.
+// Content types without a rule are not allowed.
+type UploadPolicy map[ContentType]UploadRule
+
+const mebibyte = 1 << 20
+
+// DefaultUploadPolicy returns the upload policy shared by our services. Each
+// call returns a new policy that can be changed without affecting others.
+func DefaultUploadPolicy() UploadPolicy {
+	images := []UploadPurpose{
+		UploadPurposeAvatar,
+		UploadPurposeClinicalDocument,
+		UploadPurposeLabReport,
+		UploadPurposeIdentificationDocument,
+	}
+	documents := []UploadPurpose{
+		UploadPurposeClinicalDocument,
+		UploadPurposeLabReport,
+		UploadPurposeIdentificationDocument,
+	}
+
+	return UploadPolicy{
+		ContentTypePng:   {MaxBytes: 10 * mebibyte, Purposes: images},
+		ContentTypeJpg:   {MaxBytes: 10 * mebibyte, Purposes: images},
+		ContentTypeHeic:  {MaxBytes: 10 * mebibyte, Purposes: images},
+		ContentTypeHeif:  {MaxBytes: 10 * mebibyte, Purposes: images},
+		ContentTypeWebp:  {MaxBytes: 10 * mebibyte, Purposes: images},
+		ContentTypePdf:   {MaxBytes: 25 * mebibyte, Purposes: documents},
+		ContentTypeTiff:  {MaxBytes: 50 * mebibyte, Purposes: documents},
+		ContentTypeDocx:  {MaxBytes: 10 * mebibyte, Purposes: []UploadPurpose{UploadPurposeClinicalDocument}},
+		ContentTypeXlsx:  {MaxBytes: 10 * mebibyte, Purposes: []UploadPurpose{UploadPurposeLabReport}},
+		ContentTypeCsv:   {MaxBytes: 10 * mebibyte, Purposes: []UploadPurpose{UploadPurposeLabReport}},
+		ContentTypeDicom: {MaxBytes: 500 * mebibyte, Purposes: []UploadPurpose{UploadPurposeClinicalDocument}},
+	}
+}
+
+// Allows returns true if the content type can be uploaded for the purpose
+func (p UploadPolicy) Allows(contentType ContentType, purpose UploadPurpose) bool {
+	rule, ok := p[contentType]
+	return ok && (len(rule.Purposes) == 0 || slices.Contains(rule.Purposes, purpose))
+}
+
+// Reader checks an upload against the policy while it is read. The content
+// type and purpose are checked and the start of the content is sniffed
+// before it returns, so that a disallowed or mismatched upload is rejected
+// without reading it all. The returned reader yields the whole upload and
+// fails with a FileTooLargeError as soon as the upload exceeds the maximum
+// size, so it can be streamed to storage e.g
+//
+//	upload, err := policy.Reader(enumutils.ContentTypePdf, enumutils.UploadPurposeLabReport, r.Body)
+//	if err != nil {
+//		return err
+//	}
+//	_, err = io.Copy(object, upload)
+func (p UploadPolicy) Reader(contentType ContentType, purpose UploadPurpose, r io.Reader) (io.Reader, error) {
+	if !contentType.IsValid() {
+		return nil, newInvalidEnumError(contentType.EnumName(), contentType.String(), contentType.Values())
+	}
+	if !purpose.IsValid() {
+		return nil, newInvalidEnumError(purpose.EnumName(), purpose.String(), purpose.Values())
+	}
+	if !p.Allows(contentType, purpose) {
+		return nil, &ContentTypeNotAllowedError{ContentType: contentType, Purpose: purpose, Allowed: p.allowed(purpose)}
+	}
+
+	var header bytes.Buffer
+	if err := contentType.Verify(io.TeeReader(r, &header)); err != nil {
+		return nil, err
+	}
+
+	rule := p[contentType]
+	upload := io.MultiReader(&header, r)
+	if rule.MaxBytes == 0 {
+		return upload, nil
+	}
+	return &uploadLimitReader{r: upload, remaining: rule.MaxBytes, contentType: contentType, limit: rule.MaxBytes}, nil
+}
+
+// Validate checks an upload against the policy by reading it, and returns its
+// size. It reads at most one byte more than the maximum size.
+func (p UploadPolicy) Validate(contentType ContentType, purpose UploadPurpose, r io.Reader) (int64, error) {
+	upload, err := p.Reader(contentType, purpose, r)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(io.Discard, upload)
+}
+
+// allowed returns the content types that can be uploaded for the purpose
+func (p UploadPolicy) allowed(purpose UploadPurpose) []ContentType {
+	allowed := []ContentType{}
+	for _, contentType := range AllContentType {
+		if p.Allows(contentType, purpose) {
+			allowed = append(allowed, contentType)
+		}
+	}
+	return allowed
+}
+
+// uploadLimitReader is like io.LimitedReader but fails, rather than stopping,
+// when the content is longer than the limit
+type uploadLimitReader struct {
+	r           io.Reader
+	remaining   int64
+	contentType ContentType
+	limit       int64
+	err         error
+}
+
+func (l *uploadLimitReader) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+
+	// read one byte past the limit to tell a file that is exactly the
+	// maximum size from a larger one
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.remaining {
+		l.err = &FileTooLargeError{ContentType: l.contentType, MaxBytes: l.limit}
+		n = int(l.remaining)
+		l.remaining = 0
+		return n, l.err
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,152 @@
+package enumutils_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/savannahghi/enumutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultUploadPolicy(t *testing.T) {
+	policy := enumutils.DefaultUploadPolicy()
+
+	for _, contentType := range enumutils.AllContentType {
+		assert.Contains(t, policy, contentType, "every content type has a default rule")
+	}
+	assert.True(t, policy.Allows(enumutils.ContentTypeHeic, enumutils.UploadPurposeAvatar))
+	assert.False(t, policy.Allows(enumutils.ContentTypeDicom, enumutils.UploadPurposeAvatar))
+
+	// changing one policy does not change the defaults
+	delete(policy, enumutils.ContentTypePng)
+	assert.Contains(t, enumutils.DefaultUploadPolicy(), enumutils.ContentTypePng)
+}
+
+func TestUploadPolicy_Validate(t *testing.T) {
+	pdf := append([]byte("%PDF-1.7\n"), bytes.Repeat([]byte("0"), 91)...)
+	policy := enumutils.UploadPolicy{
+		enumutils.ContentTypePdf: {
+			MaxBytes: 100,
+			Purposes: []enumutils.UploadPurpose{enumutils.UploadPurposeLabReport},
+		},
+		enumutils.ContentTypePng: {},
+	}
+
+	tests := []struct {
+		name        string
+		contentType enumutils.ContentType
+		purpose     enumutils.UploadPurpose
+		content     []byte
+		wantSize    int64
+		wantErr     error
+	}{
+		{
+			name:        "exactly the maximum size",
+			contentType: enumutils.ContentTypePdf,
+			purpose:     enumutils.UploadPurposeLabReport,
+			content:     pdf,
+			wantSize:    100,
+		},
+		{
+			name:        "no size limit or purposes",
+			contentType: enumutils.ContentTypePng,
+			purpose:     enumutils.UploadPurposeAvatar,
+			content:     samples[enumutils.ContentTypePng],
+			wantSize:    int64(len(samples[enumutils.ContentTypePng])),
+		},
+		{
+			name:        "too large",
+			contentType: enumutils.ContentTypePdf,
+			purpose:     enumutils.UploadPurposeLabReport,
+			content:     append(pdf, '0'),
+			wantErr:     enumutils.ErrFileTooLarge,
+		},
+		{
+			name:        "purpose not allowed",
+			contentType: enumutils.ContentTypePdf,
+			purpose:     enumutils.UploadPurposeAvatar,
+			content:     pdf,
+			wantErr:     enumutils.ErrContentTypeNotAllowed,
+		},
+		{
+			name:        "content type not in the policy",
+			contentType: enumutils.ContentTypeJpg,
+			purpose:     enumutils.UploadPurposeLabReport,
+			content:     samples[enumutils.ContentTypeJpg],
+			wantErr:     enumutils.ErrContentTypeNotAllowed,
+		},
+		{
+			name:        "content does not match",
+			contentType: enumutils.ContentTypePdf,
+			purpose:     enumutils.UploadPurposeLabReport,
+			content:     samples[enumutils.ContentTypePng],
+			wantErr:     enumutils.ErrContentTypeMismatch,
+		},
+		{
+			name:        "invalid content type",
+			contentType: "GIF",
+			purpose:     enumutils.UploadPurposeLabReport,
+			content:     pdf,
+			wantErr:     enumutils.ErrInvalidValue,
+		},
+		{
+			name:        "invalid purpose",
+			contentType: enumutils.ContentTypePdf,
+			purpose:     "BANNER",
+			content:     pdf,
+			wantErr:     enumutils.ErrInvalidValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := policy.Validate(tt.contentType, tt.purpose, bytes.NewReader(tt.content))
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSize, size)
+		})
+	}
+}
+
+func TestUploadPolicy_Reader(t *testing.T) {
+	policy := enumutils.UploadPolicy{enumutils.ContentTypeCsv: {MaxBytes: 20}}
+	csv := []byte("name,age\nJuma,30\n")
+
+	// the upload is yielded in full, including the bytes read to sniff it
+	upload, err := policy.Reader(enumutils.ContentTypeCsv, enumutils.UploadPurposeLabReport, bytes.NewReader(csv))
+	assert.NoError(t, err)
+	got, err := io.ReadAll(upload)
+	assert.NoError(t, err)
+	assert.Equal(t, csv, got)
+
+	large := append(csv, "Wanjiru,41\n"...)
+	upload, err = policy.Reader(enumutils.ContentTypeCsv, enumutils.UploadPurposeLabReport, bytes.NewReader(large))
+	assert.NoError(t, err)
+	got, err = io.ReadAll(upload)
+	var tooLarge *enumutils.FileTooLargeError
+	if assert.True(t, errors.As(err, &tooLarge)) {
+		assert.Equal(t, int64(20), tooLarge.MaxBytes)
+		assert.Equal(t, "CSV files must not be larger than 20 bytes", err.Error())
+	}
+	assert.Equal(t, large[:20], got)
+
+	// the error is returned by every later read
+	_, err = upload.Read(make([]byte, 10))
+	assert.True(t, errors.Is(err, enumutils.ErrFileTooLarge))
+}
+
+func TestContentTypeNotAllowedError(t *testing.T) {
+	_, err := enumutils.DefaultUploadPolicy().Reader(enumutils.ContentTypeDicom, enumutils.UploadPurposeAvatar, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "DICOM files are not allowed for AVATAR uploads, use one of PNG, JPG, HEIC, HEIF, WEBP", err.Error())
+	}
+
+	_, err = enumutils.UploadPolicy{}.Reader(enumutils.ContentTypePdf, enumutils.UploadPurposeAvatar, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "PDF files are not allowed for AVATAR uploads", err.Error())
+	}
+}